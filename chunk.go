@@ -0,0 +1,133 @@
+package generics
+
+import (
+	"iter"
+	"slices"
+)
+
+// Chunk splits a slice into consecutive chunks of size elements. The last chunk
+// holds the remaining elements and may be shorter. The chunks do not share memory
+// with the input slice.
+//
+// Chunk panics if size is less than 1.
+func Chunk[T any](arr []T, size int) [][]T {
+	return ChunkShared(slices.Clone(arr), size)
+}
+
+// ChunkShared splits a slice into chunks like Chunk, but without copying: each chunk
+// is a subslice sharing the backing array of the input slice. The capacity of each
+// chunk is limited to its length, so appending to a chunk never overwrites the next.
+//
+// ChunkShared panics if size is less than 1.
+func ChunkShared[T any](arr []T, size int) [][]T {
+	result := make([][]T, 0, chunkCount(len(arr), size))
+
+	for c := range ChunkSeq(arr, size) {
+		result = append(result, c)
+	}
+
+	return result
+}
+
+// ChunkSeq returns an iterator over the chunks of a slice, as produced by ChunkShared.
+// Each chunk shares the backing array of the input slice.
+//
+// ChunkSeq panics if size is less than 1.
+func ChunkSeq[T any](arr []T, size int) iter.Seq[[]T] {
+	checkPositive("size", size)
+
+	return func(yield func([]T) bool) {
+		for start := 0; start < len(arr); {
+			end := start + min(size, len(arr)-start)
+
+			if !yield(arr[start:end:end]) {
+				return
+			}
+
+			start = end
+		}
+	}
+}
+
+// Window returns the sliding windows of size elements over a slice, with each window
+// starting step elements after the previous one. Only full windows are returned, so
+// trailing elements that do not fill a window are dropped. The windows do not share
+// memory with the input slice or with each other.
+//
+// Window panics if size or step is less than 1.
+func Window[T any](arr []T, size int, step int) [][]T {
+	result := make([][]T, 0, windowCount(len(arr), size, step))
+
+	for w := range WindowSeq(arr, size, step) {
+		result = append(result, slices.Clone(w))
+	}
+
+	return result
+}
+
+// WindowShared returns the sliding windows of a slice like Window, but without copying:
+// each window is a subslice sharing the backing array of the input slice, so
+// overlapping windows share elements. The capacity of each window is limited to its
+// length.
+//
+// WindowShared panics if size or step is less than 1.
+func WindowShared[T any](arr []T, size int, step int) [][]T {
+	result := make([][]T, 0, windowCount(len(arr), size, step))
+
+	for w := range WindowSeq(arr, size, step) {
+		result = append(result, w)
+	}
+
+	return result
+}
+
+// WindowSeq returns an iterator over the sliding windows of a slice, as produced by
+// WindowShared. Each window shares the backing array of the input slice.
+//
+// WindowSeq panics if size or step is less than 1.
+func WindowSeq[T any](arr []T, size int, step int) iter.Seq[[]T] {
+	checkPositive("size", size)
+	checkPositive("step", step)
+
+	return func(yield func([]T) bool) {
+		for start := 0; start <= len(arr)-size; start += step {
+			end := start + size
+
+			if !yield(arr[start:end:end]) {
+				return
+			}
+
+			// Stop before advancing past the last window, which could overflow.
+			if step > len(arr)-size-start {
+				return
+			}
+		}
+	}
+}
+
+func chunkCount(n int, size int) int {
+	checkPositive("size", size)
+
+	if n == 0 {
+		return 0
+	}
+
+	return (n-1)/size + 1
+}
+
+func windowCount(n int, size int, step int) int {
+	checkPositive("size", size)
+	checkPositive("step", step)
+
+	if n < size {
+		return 0
+	}
+
+	return (n-size)/step + 1
+}
+
+func checkPositive(name string, v int) {
+	if v < 1 {
+		panic("generics: " + name + " must be at least 1")
+	}
+}
@@ -0,0 +1,170 @@
+package generics
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+)
+
+func equalChunks[T comparable](a, b [][]T) bool {
+	return slices.EqualFunc(a, b, func(x, y []T) bool { return slices.Equal(x, y) })
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		arr      []int
+		size     int
+		expected [][]int
+	}{
+		{name: "Even split", arr: []int{1, 2, 3, 4}, size: 2, expected: [][]int{{1, 2}, {3, 4}}},
+		{name: "Short last chunk", arr: []int{1, 2, 3, 4, 5}, size: 2, expected: [][]int{{1, 2}, {3, 4}, {5}}},
+		{name: "Size larger than array", arr: []int{1, 2}, size: 5, expected: [][]int{{1, 2}}},
+		{name: "Size one", arr: []int{1, 2}, size: 1, expected: [][]int{{1}, {2}}},
+		{name: "Empty array", arr: []int{}, size: 2, expected: [][]int{}},
+		{name: "Nil array", arr: nil, size: 2, expected: [][]int{}},
+		{name: "Maximum size", arr: []int{1, 2, 3}, size: math.MaxInt, expected: [][]int{{1, 2, 3}}},
+		{name: "Maximum size empty array", arr: []int{}, size: math.MaxInt, expected: [][]int{}},
+		{name: "Size near maximum", arr: []int{1, 2, 3}, size: math.MaxInt - 1, expected: [][]int{{1, 2, 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Chunk(tt.arr, tt.size); !equalChunks(got, tt.expected) {
+				t.Errorf("Chunk: expected %v, got %v", tt.expected, got)
+			}
+
+			if got := ChunkShared(tt.arr, tt.size); !equalChunks(got, tt.expected) {
+				t.Errorf("ChunkShared: expected %v, got %v", tt.expected, got)
+			}
+
+			if got := Collect(ChunkSeq(tt.arr, tt.size)); !equalChunks(got, tt.expected) {
+				t.Errorf("ChunkSeq: expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestChunkMemory(t *testing.T) {
+	arr := []int{1, 2, 3, 4}
+
+	copied := Chunk(arr, 2)
+	copied[0][0] = 100
+	if arr[0] != 1 {
+		t.Errorf("Expected Chunk not to share memory, got arr[0]==%d", arr[0])
+	}
+
+	shared := ChunkShared(arr, 2)
+	shared[0][0] = 100
+	if arr[0] != 100 {
+		t.Errorf("Expected ChunkShared to share memory, got arr[0]==%d", arr[0])
+	}
+
+	_ = append(shared[0], 200)
+	if arr[2] != 3 {
+		t.Errorf("Expected append to a shared chunk not to overwrite the next, got arr[2]==%d", arr[2])
+	}
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		arr      []int
+		size     int
+		step     int
+		expected [][]int
+	}{
+		{name: "Sliding by one", arr: []int{1, 2, 3, 4}, size: 2, step: 1, expected: [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{name: "Step equals size", arr: []int{1, 2, 3, 4}, size: 2, step: 2, expected: [][]int{{1, 2}, {3, 4}}},
+		{name: "Step larger than size", arr: []int{1, 2, 3, 4, 5, 6}, size: 2, step: 3, expected: [][]int{{1, 2}, {4, 5}}},
+		{name: "Partial window dropped", arr: []int{1, 2, 3, 4, 5}, size: 3, step: 2, expected: [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{name: "Size equals length", arr: []int{1, 2, 3}, size: 3, step: 1, expected: [][]int{{1, 2, 3}}},
+		{name: "Size larger than length", arr: []int{1, 2}, size: 3, step: 1, expected: [][]int{}},
+		{name: "Nil array", arr: nil, size: 2, step: 1, expected: [][]int{}},
+		{name: "Maximum step", arr: []int{1, 2, 3}, size: 1, step: math.MaxInt, expected: [][]int{{1}}},
+		{name: "Maximum size", arr: []int{1, 2, 3}, size: math.MaxInt, step: 1, expected: [][]int{}},
+		{name: "Maximum size and step", arr: []int{1, 2, 3}, size: math.MaxInt, step: math.MaxInt, expected: [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Window(tt.arr, tt.size, tt.step); !equalChunks(got, tt.expected) {
+				t.Errorf("Window: expected %v, got %v", tt.expected, got)
+			}
+
+			if got := WindowShared(tt.arr, tt.size, tt.step); !equalChunks(got, tt.expected) {
+				t.Errorf("WindowShared: expected %v, got %v", tt.expected, got)
+			}
+
+			if got := Collect(WindowSeq(tt.arr, tt.size, tt.step)); !equalChunks(got, tt.expected) {
+				t.Errorf("WindowSeq: expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWindowMemory(t *testing.T) {
+	arr := []int{1, 2, 3}
+
+	copied := Window(arr, 2, 1)
+	copied[0][1] = 100
+	if arr[1] != 2 || copied[1][0] != 2 {
+		t.Errorf("Expected Window not to share memory, got arr %v and windows %v", arr, copied)
+	}
+
+	shared := WindowShared(arr, 2, 1)
+	shared[0][1] = 100
+	if arr[1] != 100 || shared[1][0] != 100 {
+		t.Errorf("Expected WindowShared to share memory, got arr %v and windows %v", arr, shared)
+	}
+}
+
+func TestChunkInvalidSize(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{name: "Chunk zero size", f: func() { Chunk([]int{1}, 0) }},
+		{name: "ChunkSeq negative size", f: func() { ChunkSeq([]int{1}, -1) }},
+		{name: "Window zero size", f: func() { Window([]int{1}, 0, 1) }},
+		{name: "WindowShared zero step", f: func() { WindowShared([]int{1}, 1, 0) }},
+		{name: "WindowSeq zero step", f: func() { WindowSeq([]int{1}, 1, 0) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected panic")
+				}
+			}()
+
+			tt.f()
+		})
+	}
+}
+
+func ExampleChunk() {
+	ids := []int{1, 2, 3, 4, 5, 6, 7}
+
+	for batch := range ChunkSeq(ids, 3) {
+		fmt.Println(batch)
+	}
+	// Output:
+	// [1 2 3]
+	// [4 5 6]
+	// [7]
+}
+
+func ExampleWindow() {
+	readings := []int{1, 2, 3, 4, 5}
+
+	for _, w := range Window(readings, 3, 1) {
+		fmt.Println(Reduce(w, 0, func(acc, a int) int { return acc + a }))
+	}
+	// Output:
+	// 6
+	// 9
+	// 12
+}
@@ -0,0 +1,37 @@
+package generics
+
+// Coalesce returns the first of vals that is not the zero value of its type, or the
+// zero value if they all are. Zero is decided as by IsZeroValue.
+func Coalesce[T any](vals ...T) T {
+	for _, v := range vals {
+		if !IsZeroValue(v) {
+			return v
+		}
+	}
+
+	var zero T
+	return zero
+}
+
+// DefaultIfZero returns v, or def if v is the zero value of its type.
+func DefaultIfZero[T any](v T, def T) T {
+	if IsZeroValue(v) {
+		return def
+	}
+
+	return v
+}
+
+// FirstNonZeroFunc calls each of fs in turn and returns the first result that is not
+// the zero value of its type, or the zero value if none is. Functions after the first
+// non-zero result are not called, so it suits defaults that are expensive to compute.
+func FirstNonZeroFunc[T any](fs ...func() T) T {
+	for _, f := range fs {
+		if v := f(); !IsZeroValue(v) {
+			return v
+		}
+	}
+
+	var zero T
+	return zero
+}
@@ -0,0 +1,67 @@
+package generics
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCoalesce(t *testing.T) {
+	if got := Coalesce("", "a", "b"); got != "a" {
+		t.Errorf("Expected a, got %q", got)
+	}
+
+	if got := Coalesce(0, 0); got != 0 {
+		t.Errorf("Expected 0, got %d", got)
+	}
+
+	if got := Coalesce[int](); got != 0 {
+		t.Errorf("Expected 0, got %d", got)
+	}
+
+	var nilSlice []int
+	if got := Coalesce(nilSlice, []int{}, []int{1}); got == nil || len(got) != 0 {
+		t.Errorf("Expected empty non-nil slice, got %v", got)
+	}
+}
+
+func TestDefaultIfZero(t *testing.T) {
+	if got := DefaultIfZero(0, 8080); got != 8080 {
+		t.Errorf("Expected 8080, got %d", got)
+	}
+
+	if got := DefaultIfZero(443, 8080); got != 443 {
+		t.Errorf("Expected 443, got %d", got)
+	}
+}
+
+func TestFirstNonZeroFunc(t *testing.T) {
+	calls := 0
+	call := func(v string) func() string {
+		return func() string {
+			calls++
+			return v
+		}
+	}
+
+	got := FirstNonZeroFunc(call(""), call("b"), call("c"))
+	if got != "b" {
+		t.Errorf("Expected b, got %q", got)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+
+	if got := FirstNonZeroFunc(call(""), call("")); got != "" {
+		t.Errorf("Expected empty string, got %q", got)
+	}
+}
+
+func ExampleCoalesce() {
+	var flagValue, envValue string
+	envValue = "from-env"
+
+	fmt.Println(Coalesce(flagValue, envValue, "default"))
+	// Output:
+	// from-env
+}
@@ -0,0 +1,357 @@
+package generics
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// DefaultShardCount is the number of shards used by NewConcurrentMap and NewConcurrentSet.
+const DefaultShardCount = 32
+
+// ConcurrentMap is a map that is safe for concurrent use by multiple goroutines.
+//
+// Keys are spread across a fixed number of shards, each guarded by its own lock,
+// so that operations on different keys rarely contend. Unlike sync.Map it is typed,
+// so no type assertions are needed.
+//
+// A ConcurrentMap must be created with NewConcurrentMap or NewConcurrentMapWithShards.
+type ConcurrentMap[K comparable, V any] struct {
+	seed   maphash.Seed
+	shards []*mapShard[K, V]
+}
+
+type mapShard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// NewConcurrentMap creates an empty ConcurrentMap with DefaultShardCount shards.
+func NewConcurrentMap[K comparable, V any]() *ConcurrentMap[K, V] {
+	return NewConcurrentMapWithShards[K, V](DefaultShardCount)
+}
+
+// NewConcurrentMapWithShards creates an empty ConcurrentMap with at least n shards.
+// The number of shards is rounded up to a power of two, with a minimum of one.
+func NewConcurrentMapWithShards[K comparable, V any](n int) *ConcurrentMap[K, V] {
+	count := 1
+	for count < n {
+		count <<= 1
+	}
+
+	shards := make([]*mapShard[K, V], count)
+	for i := range shards {
+		shards[i] = &mapShard[K, V]{m: make(map[K]V)}
+	}
+
+	return &ConcurrentMap[K, V]{
+		seed:   maphash.MakeSeed(),
+		shards: shards,
+	}
+}
+
+func (c *ConcurrentMap[K, V]) shard(key K) *mapShard[K, V] {
+	return c.shards[hashKey(c.seed, key)&uint64(len(c.shards)-1)]
+}
+
+// Load returns the value stored under key and true, or the zero value and false
+// if no value is present.
+func (c *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	s := c.shard(key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v, ok := s.m[key]
+
+	return v, ok
+}
+
+// Store sets the value for key.
+func (c *ConcurrentMap[K, V]) Store(key K, value V) {
+	s := c.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m[key] = value
+}
+
+// LoadOrStore returns the existing value for key if present, and loaded is true.
+// Otherwise, it stores value and returns it, and loaded is false.
+func (c *ConcurrentMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s := c.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := s.m[key]; ok {
+		return v, true
+	}
+
+	s.m[key] = value
+
+	return value, false
+}
+
+// LoadAndDelete deletes the value for key, returning the previous value if any.
+// loaded reports whether the key was present.
+func (c *ConcurrentMap[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	s := c.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, loaded = s.m[key]
+	delete(s.m, key)
+
+	return value, loaded
+}
+
+// Delete deletes the value for key.
+func (c *ConcurrentMap[K, V]) Delete(key K) {
+	s := c.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.m, key)
+}
+
+// Compute atomically updates the value for key.
+//
+// f is called with the current value and whether it is present, while the key's
+// shard is locked, so f must not call methods on the same ConcurrentMap.
+// If f returns keep as true, its result is stored; otherwise the key is deleted.
+// Compute returns the new value and whether it was stored.
+func (c *ConcurrentMap[K, V]) Compute(key K, f func(old V, loaded bool) (value V, keep bool)) (V, bool) {
+	s := c.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, loaded := s.m[key]
+	value, keep := f(old, loaded)
+
+	if keep {
+		s.m[key] = value
+	} else {
+		delete(s.m, key)
+	}
+
+	return value, keep
+}
+
+// Range calls f sequentially for each key and value in the map.
+// If f returns false, Range stops the iteration.
+//
+// Like sync.Map, Range does not correspond to a consistent snapshot of the whole map.
+// Each shard is copied before f is called for its entries, so f may safely modify
+// the map.
+func (c *ConcurrentMap[K, V]) Range(f func(key K, value V) bool) {
+	for _, s := range c.shards {
+		s.mu.RLock()
+		entries := make([]Pair[K, V], 0, len(s.m))
+		for k, v := range s.m {
+			entries = append(entries, Pair[K, V]{A: k, B: v})
+		}
+		s.mu.RUnlock()
+
+		for _, e := range entries {
+			if !f(e.A, e.B) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of entries in the map.
+// The result may be stale if the map is modified concurrently.
+func (c *ConcurrentMap[K, V]) Len() int {
+	n := 0
+
+	for _, s := range c.shards {
+		s.mu.RLock()
+		n += len(s.m)
+		s.mu.RUnlock()
+	}
+
+	return n
+}
+
+// Clear deletes every entry in the map.
+func (c *ConcurrentMap[K, V]) Clear() {
+	for _, s := range c.shards {
+		s.mu.Lock()
+		clear(s.m)
+		s.mu.Unlock()
+	}
+}
+
+// ConcurrentSet is a Set that is safe for concurrent use by multiple goroutines.
+//
+// A ConcurrentSet must be created with NewConcurrentSet or NewConcurrentSetWithShards.
+type ConcurrentSet[T comparable] struct {
+	m *ConcurrentMap[T, struct{}]
+}
+
+// NewConcurrentSet creates a ConcurrentSet with DefaultShardCount shards,
+// containing the given items.
+func NewConcurrentSet[T comparable](items ...T) *ConcurrentSet[T] {
+	s := NewConcurrentSetWithShards[T](DefaultShardCount)
+	s.Add(items...)
+
+	return s
+}
+
+// NewConcurrentSetWithShards creates an empty ConcurrentSet with at least n shards.
+func NewConcurrentSetWithShards[T comparable](n int) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{m: NewConcurrentMapWithShards[T, struct{}](n)}
+}
+
+// Add adds the items to the set. It returns the number of items that were not
+// already present.
+func (s *ConcurrentSet[T]) Add(items ...T) int {
+	added := 0
+
+	for _, v := range items {
+		if _, loaded := s.m.LoadOrStore(v, struct{}{}); !loaded {
+			added++
+		}
+	}
+
+	return added
+}
+
+// Remove removes the items from the set. It returns the number of items that
+// were present.
+func (s *ConcurrentSet[T]) Remove(items ...T) int {
+	removed := 0
+
+	for _, v := range items {
+		if _, loaded := s.m.LoadAndDelete(v); loaded {
+			removed++
+		}
+	}
+
+	return removed
+}
+
+// Has returns true if the set contains v.
+func (s *ConcurrentSet[T]) Has(v T) bool {
+	_, ok := s.m.Load(v)
+	return ok
+}
+
+// Len returns the number of items in the set.
+// The result may be stale if the set is modified concurrently.
+func (s *ConcurrentSet[T]) Len() int {
+	return s.m.Len()
+}
+
+// Range calls f sequentially for each item in the set.
+// If f returns false, Range stops the iteration.
+func (s *ConcurrentSet[T]) Range(f func(T) bool) {
+	s.m.Range(func(v T, _ struct{}) bool {
+		return f(v)
+	})
+}
+
+// ToSet returns a copy of the items as a Set.
+func (s *ConcurrentSet[T]) ToSet() Set[T] {
+	result := make(Set[T], s.Len())
+
+	s.Range(func(v T) bool {
+		result.Add(v)
+		return true
+	})
+
+	return result
+}
+
+// hashKey hashes a comparable value such that equal values have equal hashes.
+func hashKey[K comparable](seed maphash.Seed, key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return maphash.String(seed, k)
+	case int:
+		return mixHash(seed, uint64(k))
+	case int64:
+		return mixHash(seed, uint64(k))
+	case uint64:
+		return mixHash(seed, k)
+	}
+
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeHash(&h, reflect.ValueOf(&key).Elem())
+
+	return h.Sum64()
+}
+
+func mixHash(seed maphash.Seed, v uint64) uint64 {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+
+	return maphash.Bytes(seed, b[:])
+}
+
+// writeHash writes a representation of v to h. Values that compare equal with ==
+// always produce the same representation.
+func writeHash(h *maphash.Hash, v reflect.Value) {
+	var b [8]byte
+
+	writeUint := func(u uint64) {
+		binary.LittleEndian.PutUint64(b[:], u)
+		_, _ = h.Write(b[:])
+	}
+
+	writeFloat := func(f float64) {
+		if f == 0 {
+			// +0 and -0 are equal, so they must hash the same.
+			f = 0
+		}
+		writeUint(math.Float64bits(f))
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(real(v.Complex()))
+		writeFloat(imag(v.Complex()))
+	case reflect.String:
+		_, _ = h.WriteString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint(uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeHash(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeHash(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			writeUint(0)
+		} else {
+			writeHash(h, v.Elem())
+		}
+	default:
+		panic(fmt.Sprintf("generics: hash of unhashable type %s", v.Type()))
+	}
+}
@@ -0,0 +1,435 @@
+package generics
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	m := NewConcurrentMap[string, int]()
+
+	if _, ok := m.Load("a"); ok {
+		t.Errorf("Expected empty map")
+	}
+
+	m.Store("a", 1)
+	if v, ok := m.Load("a"); !ok || v != 1 {
+		t.Errorf("Expected (1, true), got (%v, %v)", v, ok)
+	}
+
+	if v, loaded := m.LoadOrStore("a", 2); !loaded || v != 1 {
+		t.Errorf("Expected (1, true), got (%v, %v)", v, loaded)
+	}
+
+	if v, loaded := m.LoadOrStore("b", 2); loaded || v != 2 {
+		t.Errorf("Expected (2, false), got (%v, %v)", v, loaded)
+	}
+
+	if m.Len() != 2 {
+		t.Errorf("Expected length 2, got %d", m.Len())
+	}
+
+	if v, loaded := m.LoadAndDelete("a"); !loaded || v != 1 {
+		t.Errorf("Expected (1, true), got (%v, %v)", v, loaded)
+	}
+
+	if _, loaded := m.LoadAndDelete("a"); loaded {
+		t.Errorf("Expected a to be deleted")
+	}
+
+	m.Delete("b")
+	if m.Len() != 0 {
+		t.Errorf("Expected empty map, got length %d", m.Len())
+	}
+}
+
+func TestConcurrentMapCompute(t *testing.T) {
+	m := NewConcurrentMap[string, int]()
+	increment := func(old int, loaded bool) (int, bool) {
+		return old + 1, true
+	}
+
+	tests := []struct {
+		name          string
+		f             func(int, bool) (int, bool)
+		expected      int
+		expectedKept  bool
+		expectedFound bool
+	}{
+		{name: "Insert", f: increment, expected: 1, expectedKept: true, expectedFound: true},
+		{name: "Update", f: increment, expected: 2, expectedKept: true, expectedFound: true},
+		{
+			name:          "Delete",
+			f:             func(old int, loaded bool) (int, bool) { return 0, false },
+			expected:      0,
+			expectedKept:  false,
+			expectedFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, kept := m.Compute("a", tt.f)
+
+			if v != tt.expected || kept != tt.expectedKept {
+				t.Errorf("Expected (%v, %v), got (%v, %v)", tt.expected, tt.expectedKept, v, kept)
+			}
+
+			if _, ok := m.Load("a"); ok != tt.expectedFound {
+				t.Errorf("Expected found %v, got %v", tt.expectedFound, ok)
+			}
+		})
+	}
+}
+
+func TestConcurrentMapRange(t *testing.T) {
+	m := NewConcurrentMapWithShards[int, int](4)
+	for i := 0; i < 100; i++ {
+		m.Store(i, i*2)
+	}
+
+	sum := 0
+	m.Range(func(k, v int) bool {
+		if k >= 1000 {
+			return true
+		}
+		if v != k*2 {
+			t.Errorf("Expected %d, got %d", k*2, v)
+		}
+		sum += k
+		// Modifying the map during Range must not deadlock.
+		m.Store(k+1000, 0)
+		return true
+	})
+
+	if sum != 4950 {
+		t.Errorf("Expected sum 4950, got %d", sum)
+	}
+
+	visited := 0
+	m.Range(func(k, v int) bool {
+		visited++
+		return visited < 10
+	})
+
+	if visited != 10 {
+		t.Errorf("Expected Range to stop after 10, got %d", visited)
+	}
+
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("Expected empty map, got length %d", m.Len())
+	}
+}
+
+func TestConcurrentMapKeys(t *testing.T) {
+	type key struct {
+		Name string
+		ID   int
+		f    float64
+		p    *int
+		i    any
+	}
+
+	x := 1
+
+	tests := []struct {
+		name string
+		a, b any
+	}{
+		{name: "Ints", a: 1, b: 1},
+		{name: "Floats with signed zero", a: 0.0, b: math.Copysign(0, -1)},
+		{name: "Structs", a: key{Name: "a", ID: 1, f: 1.5, p: &x, i: "z"}, b: key{Name: "a", ID: 1, f: 1.5, p: &x, i: "z"}},
+		{name: "Arrays", a: [2]string{"a", "b"}, b: [2]string{"a", "b"}},
+		{name: "Nil interface", a: nil, b: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewConcurrentMapWithShards[any, int](64)
+			m.Store(tt.a, 1)
+
+			if v, ok := m.Load(tt.b); !ok || v != 1 {
+				t.Errorf("Expected equal keys to be found, got (%v, %v)", v, ok)
+			}
+		})
+	}
+
+	t.Run("Unhashable", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Expected panic for unhashable key")
+			}
+		}()
+
+		NewConcurrentMap[any, int]().Store([]int{1}, 1)
+	})
+}
+
+func TestConcurrentMapRace(t *testing.T) {
+	const goroutines = 8
+	const keys = 100
+
+	tests := []struct {
+		name string
+		op   func(m *ConcurrentMap[int, int], g, k int)
+		want func(m *ConcurrentMap[int, int]) error
+	}{
+		{
+			name: "Store and Load",
+			op: func(m *ConcurrentMap[int, int], g, k int) {
+				m.Store(k, k)
+				m.Load(k)
+			},
+			want: func(m *ConcurrentMap[int, int]) error {
+				if m.Len() != keys {
+					return fmt.Errorf("expected %d keys, got %d", keys, m.Len())
+				}
+				return nil
+			},
+		},
+		{
+			name: "Compute increments",
+			op: func(m *ConcurrentMap[int, int], g, k int) {
+				m.Compute(k, func(old int, _ bool) (int, bool) { return old + 1, true })
+			},
+			want: func(m *ConcurrentMap[int, int]) error {
+				var err error
+				m.Range(func(k, v int) bool {
+					if v != goroutines {
+						err = fmt.Errorf("expected %d at key %d, got %d", goroutines, k, v)
+						return false
+					}
+					return true
+				})
+				return err
+			},
+		},
+		{
+			name: "LoadOrStore first wins",
+			op: func(m *ConcurrentMap[int, int], g, k int) {
+				actual, _ := m.LoadOrStore(k, g)
+				if v, _ := m.Load(k); v != actual {
+					panic("LoadOrStore returned a value that was not stored")
+				}
+			},
+			want: func(m *ConcurrentMap[int, int]) error {
+				if m.Len() != keys {
+					return fmt.Errorf("expected %d keys, got %d", keys, m.Len())
+				}
+				return nil
+			},
+		},
+		{
+			name: "Store, Range and Delete",
+			op: func(m *ConcurrentMap[int, int], g, k int) {
+				m.Store(k, g)
+				m.Range(func(int, int) bool { return true })
+				m.Delete(k)
+			},
+			want: func(m *ConcurrentMap[int, int]) error {
+				if m.Len() != 0 {
+					return fmt.Errorf("expected empty map, got %d keys", m.Len())
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewConcurrentMapWithShards[int, int](4)
+
+			var wg sync.WaitGroup
+			for g := 0; g < goroutines; g++ {
+				wg.Add(1)
+				go func(g int) {
+					defer wg.Done()
+					for k := 0; k < keys; k++ {
+						tt.op(m, g, k)
+					}
+				}(g)
+			}
+			wg.Wait()
+
+			if err := tt.want(m); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestConcurrentSet(t *testing.T) {
+	s := NewConcurrentSet("a", "b")
+
+	if added := s.Add("b", "c"); added != 1 {
+		t.Errorf("Expected 1 added, got %d", added)
+	}
+
+	if !s.Has("c") || s.Has("d") {
+		t.Errorf("Expected set to contain c and not d")
+	}
+
+	if removed := s.Remove("a", "d"); removed != 1 {
+		t.Errorf("Expected 1 removed, got %d", removed)
+	}
+
+	if s.Len() != 2 {
+		t.Errorf("Expected length 2, got %d", s.Len())
+	}
+
+	if !s.ToSet().Equal(NewSet("b", "c")) {
+		t.Errorf("Expected {b c}, got %v", s.ToSet())
+	}
+}
+
+func TestConcurrentSetRace(t *testing.T) {
+	const goroutines = 8
+
+	s := NewConcurrentSetWithShards[int](4)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	total := 0
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			added := 0
+			for k := 0; k < 100; k++ {
+				added += s.Add(k)
+				s.Has(k)
+			}
+			mu.Lock()
+			total += added
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if total != 100 {
+		t.Errorf("Expected each item to be added exactly once, got %d additions", total)
+	}
+
+	if s.Len() != 100 {
+		t.Errorf("Expected length 100, got %d", s.Len())
+	}
+}
+
+func benchmarkKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+	return keys
+}
+
+func BenchmarkConcurrentMapLoad(b *testing.B) {
+	keys := benchmarkKeys(1024)
+	m := NewConcurrentMap[string, int]()
+	for i, k := range keys {
+		m.Store(k, i)
+	}
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.Load(keys[i%len(keys)])
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncMapLoad(b *testing.B) {
+	keys := benchmarkKeys(1024)
+	var m sync.Map
+	for i, k := range keys {
+		m.Store(k, i)
+	}
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if v, ok := m.Load(keys[i%len(keys)]); ok {
+				_ = v.(int)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrentMapStore(b *testing.B) {
+	keys := benchmarkKeys(1024)
+	m := NewConcurrentMap[string, int]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.Store(keys[i%len(keys)], i)
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncMapStore(b *testing.B) {
+	keys := benchmarkKeys(1024)
+	var m sync.Map
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.Store(keys[i%len(keys)], i)
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrentMapLoadOrStore(b *testing.B) {
+	keys := benchmarkKeys(1024)
+	m := NewConcurrentMap[string, int]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.LoadOrStore(keys[i%len(keys)], i)
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncMapLoadOrStore(b *testing.B) {
+	keys := benchmarkKeys(1024)
+	var m sync.Map
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.LoadOrStore(keys[i%len(keys)], i)
+			i++
+		}
+	})
+}
+
+func ExampleConcurrentMap() {
+	counts := NewConcurrentMap[string, int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			counts.Compute("hits", func(old int, _ bool) (int, bool) {
+				return old + 1, true
+			})
+		}()
+	}
+	wg.Wait()
+
+	hits, _ := counts.Load("hits")
+	fmt.Println(hits)
+	// Output: 10
+}
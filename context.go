@@ -0,0 +1,119 @@
+package generics
+
+import (
+	"context"
+	"fmt"
+)
+
+// SkippedError is recorded in a MapError for an element that was not processed
+// because the context was done before it was reached.
+//
+// It matches both ErrSkipped and the context error with errors.Is, so callers can
+// tell skipped elements apart from failures, or check why they were skipped.
+type SkippedError struct {
+	// Cause is the reason the context was done, as returned by context.Cause,
+	// such as context.Canceled or context.DeadlineExceeded.
+	Cause error
+}
+
+// Error returns a string representation of the SkippedError.
+func (e *SkippedError) Error() string {
+	return fmt.Sprintf("skipped: %v", e.Cause)
+}
+
+// Unwrap returns ErrSkipped and the cause.
+func (e *SkippedError) Unwrap() []error {
+	return []error{ErrSkipped, e.Cause}
+}
+
+// skipRemaining records a SkippedError with the cause of ctx for every index from
+// start up to n.
+func skipRemaining(ctx context.Context, err *MapError, start int, n int) {
+	for i := start; i < n; i++ {
+		err.Add(i, &SkippedError{Cause: context.Cause(ctx)})
+	}
+}
+
+// ForEachCtx is like ForEach, but passes ctx to f and stops once ctx is done.
+//
+// ctx is checked before each element. If it is done, the element and every later
+// one are recorded in a MapError as a *SkippedError. If f returns an error,
+// ForEachCtx stops and the error is recorded at its index; later elements are not
+// recorded. If every element is processed without error, it returns nil.
+func ForEachCtx[A any](ctx context.Context, arr []A, f func(context.Context, A) error) error {
+	err := NewMapError()
+
+	for i, a := range arr {
+		if ctx.Err() != nil {
+			skipRemaining(ctx, err, i, len(arr))
+			break
+		}
+
+		if e := f(ctx, a); e != nil {
+			err.Add(i, e)
+			break
+		}
+	}
+
+	if err.HasError() {
+		return err
+	}
+
+	return nil
+}
+
+// ApplyCtx is like Apply, but passes ctx to f and stops once ctx is done.
+//
+// ctx is checked before each element. If it is done, the element and every later
+// one are recorded as a *SkippedError in the returned MapError, alongside the errors
+// returned by f for the elements that were processed.
+func ApplyCtx[A any](ctx context.Context, f func(context.Context, A) error, arr []A) error {
+	err := NewMapError()
+
+	for i, a := range arr {
+		if ctx.Err() != nil {
+			skipRemaining(ctx, err, i, len(arr))
+			break
+		}
+
+		if e := f(ctx, a); e != nil {
+			err.Add(i, e)
+		}
+	}
+
+	if err.HasError() {
+		return err
+	}
+
+	return nil
+}
+
+// MapCtx is like Map, but passes ctx to f and stops once ctx is done.
+//
+// ctx is checked before each element. If it is done, the element and every later one
+// are left as the zero value in the results and recorded as a *SkippedError in the
+// returned MapError, alongside the errors returned by f.
+func MapCtx[A any, B any](ctx context.Context, f func(context.Context, A) (B, error), arr []A) ([]B, error) {
+	results := make([]B, len(arr))
+
+	err := NewMapError()
+
+	for i, a := range arr {
+		if ctx.Err() != nil {
+			skipRemaining(ctx, err, i, len(arr))
+			break
+		}
+
+		b, e := f(ctx, a)
+		if e != nil {
+			err.Add(i, e)
+		}
+		results[i] = b
+	}
+
+	if err.HasError() {
+		return results, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,208 @@
+package generics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSkippedError(t *testing.T) {
+	err := &SkippedError{Cause: context.DeadlineExceeded}
+
+	if err.Error() != "skipped: context deadline exceeded" {
+		t.Errorf("Expected %q, got %q", "skipped: context deadline exceeded", err.Error())
+	}
+
+	if !errors.Is(err, ErrSkipped) {
+		t.Errorf("Expected errors.Is to match ErrSkipped")
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected errors.Is to match context.DeadlineExceeded")
+	}
+}
+
+func TestForEachCtx(t *testing.T) {
+	t.Run("No errors", func(t *testing.T) {
+		sum := 0
+
+		err := ForEachCtx(context.Background(), []int{1, 2, 3}, func(_ context.Context, a int) error {
+			sum += a
+			return nil
+		})
+
+		if err != nil {
+			t.Errorf("Expected nil error, got %v", err)
+		}
+
+		if sum != 6 {
+			t.Errorf("Expected sum 6, got %v", sum)
+		}
+	})
+
+	t.Run("Stops at failure", func(t *testing.T) {
+		var seen []int
+
+		err := ForEachCtx(context.Background(), []int{1, 2, 3, 4}, func(_ context.Context, a int) error {
+			seen = append(seen, a)
+			if a == 2 {
+				return TestErrNotEven
+			}
+			return nil
+		})
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		if !slices.Equal(mapError.Indices(), []int{1}) || !errors.Is(mapError.Errors[1], TestErrNotEven) {
+			t.Errorf("Expected only index 1 to fail, got %v", mapError)
+		}
+
+		if !slices.Equal(seen, []int{1, 2}) {
+			t.Errorf("Expected [1 2] to be processed, got %v", seen)
+		}
+	})
+
+	t.Run("Cancelled during run", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := ForEachCtx(ctx, []int{1, 2, 3, 4}, func(_ context.Context, a int) error {
+			if a == 2 {
+				cancel()
+			}
+			return nil
+		})
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		if !slices.Equal(mapError.Skipped(), []int{2, 3}) {
+			t.Errorf("Expected indices [2 3] to be skipped, got %v", mapError.Skipped())
+		}
+
+		if mapError.Failures().HasError() {
+			t.Errorf("Expected no failures, got %v", mapError.Failures())
+		}
+	})
+}
+
+func TestApplyCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var seen []int
+	err := ApplyCtx(ctx, func(_ context.Context, a int) error {
+		seen = append(seen, a)
+		if a == 3 {
+			cancel()
+		}
+		if a%2 != 0 {
+			return TestErrNotEven
+		}
+		return nil
+	}, []int{1, 2, 3, 4, 5})
+
+	var mapError *MapError
+	if !errors.As(err, &mapError) {
+		t.Fatalf("Expected *MapError, got %v", err)
+	}
+
+	if !slices.Equal(seen, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] to be processed, got %v", seen)
+	}
+
+	if !slices.Equal(mapError.Failures().Indices(), []int{0, 2}) {
+		t.Errorf("Expected failures at [0 2], got %v", mapError.Failures().Indices())
+	}
+
+	if !slices.Equal(mapError.Skipped(), []int{3, 4}) {
+		t.Errorf("Expected indices [3 4] to be skipped, got %v", mapError.Skipped())
+	}
+
+	if mapError.Count(context.Canceled) != 2 {
+		t.Errorf("Expected 2 errors matching context.Canceled, got %d", mapError.Count(context.Canceled))
+	}
+
+	if err := ApplyCtx(context.Background(), func(context.Context, int) error { return nil }, []int{1, 2}); err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+}
+
+func TestMapCtx(t *testing.T) {
+	t.Run("Deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+		defer cancel()
+
+		results, err := MapCtx(ctx, func(ctx context.Context, a int) (int, error) {
+			if _, ok := ctx.Deadline(); !ok {
+				t.Errorf("Expected the context to be passed to f")
+			}
+			return a * 2, nil
+		}, []int{1, 2, 3})
+
+		if err != nil {
+			t.Errorf("Expected nil error, got %v", err)
+		}
+
+		if !slices.Equal(results, []int{2, 4, 6}) {
+			t.Errorf("Expected [2 4 6], got %v", results)
+		}
+	})
+
+	t.Run("Cancelled with cause", func(t *testing.T) {
+		ctx, cancel := context.WithCancelCause(context.Background())
+		defer cancel(nil)
+
+		shutdown := errors.New("shutting down")
+
+		results, err := MapCtx(ctx, func(_ context.Context, a int) (int, error) {
+			if a == 2 {
+				cancel(shutdown)
+			}
+			return a * 2, nil
+		}, []int{1, 2, 3})
+
+		if !slices.Equal(results, []int{2, 4, 0}) {
+			t.Errorf("Expected [2 4 0], got %v", results)
+		}
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		if !errors.Is(mapError.Errors[2], shutdown) {
+			t.Errorf("Expected the cancellation cause at index 2, got %v", mapError.Errors[2])
+		}
+	})
+}
+
+func ExampleApplyCtx() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := ApplyCtx(ctx, func(_ context.Context, id int) error {
+		if id == 2 {
+			cancel()
+			return errors.New("request failed")
+		}
+		return nil
+	}, []int{1, 2, 3, 4})
+
+	var mapError *MapError
+	if errors.As(err, &mapError) {
+		fmt.Println("failed:", mapError.Failures().Indices())
+		fmt.Println("skipped:", mapError.Skipped())
+	}
+	// Output:
+	// failed: [1]
+	// skipped: [2 3]
+}
@@ -0,0 +1,277 @@
+package generics
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ApplyDefaults fills the zero-valued fields of the struct pointed to by dst with the
+// corresponding fields of defaults. Zero is decided as by IsZeroValue.
+//
+// Nested structs, and non-nil pointers to structs in both dst and defaults, are filled
+// field by field, unless the struct has an IsZero() bool method such as time.Time,
+// in which case it is replaced as a whole when zero. Other fields, including slices,
+// maps and pointers, are copied shallowly, so dst may share them with defaults.
+// Unexported fields are left unchanged. A pointer in dst that leads back to a struct
+// already being filled forms a cycle and is not followed again.
+//
+// If T is not a struct, *dst is replaced by defaults when it is zero.
+// ApplyDefaults does nothing if dst is nil.
+func ApplyDefaults[T any](dst *T, defaults T) {
+	if dst == nil {
+		return
+	}
+
+	rv := reflect.ValueOf(dst)
+	visiting := visitSet{}
+	visiting.enter(rv)
+
+	applyDefaults(rv.Elem(), reflect.ValueOf(&defaults).Elem(), visiting)
+}
+
+// applyDefaults fills dst from def, which have the same type. dst must be settable.
+func applyDefaults(dst, def reflect.Value, visiting visitSet) {
+	switch {
+	case isFillableStruct(dst.Type()):
+		t := dst.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).IsExported() {
+				applyDefaults(dst.Field(i), def.Field(i), visiting)
+			}
+		}
+	case dst.Kind() == reflect.Pointer && isFillableStruct(dst.Type().Elem()) && !dst.IsNil() && !def.IsNil():
+		if !visiting.enter(dst) {
+			return
+		}
+		defer visiting.leave(dst)
+
+		applyDefaults(dst.Elem(), def.Elem(), visiting)
+	case IsZeroValue(dst.Interface()):
+		dst.Set(def)
+	}
+}
+
+// isFillableStruct reports whether values of type t are filled field by field.
+func isFillableStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !t.Implements(zeroerType)
+}
+
+var (
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	durationType        = reflect.TypeFor[time.Duration]()
+)
+
+// SetDefaults sets the zero-valued fields of the struct pointed to by ptr from their
+// `default:"..."` struct tags. Zero is decided as by IsZeroValue, so fields that are
+// already set are left unchanged.
+//
+// Tag values are parsed according to the type of the field:
+//   - strings are used as they are, and booleans and numbers are parsed with strconv;
+//   - time.Duration is parsed with time.ParseDuration;
+//   - types implementing encoding.TextUnmarshaler, such as time.Time which expects
+//     RFC 3339, are parsed with UnmarshalText;
+//   - slices are parsed from a comma-separated list of elements;
+//   - pointers are set to a newly allocated value parsed from the tag.
+//
+// Nested structs without a tag, and pointers to them, are filled recursively.
+// A nil pointer to a struct is only allocated if one of its fields gets a default,
+// and never if the struct type is already being filled further up, so recursive
+// types such as trees and linked lists only have their existing nodes filled.
+// A non-nil pointer that leads back to a struct already being filled forms a cycle
+// and is not followed again. Unexported fields are ignored.
+//
+// Every tag is attempted. If any fail to parse, SetDefaults returns a *FieldError
+// keyed by the dotted path of each failing field, and those fields are left unchanged.
+// It returns an error if ptr is not a non-nil pointer to a struct.
+func SetDefaults(ptr any) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("generics: SetDefaults requires a non-nil pointer to a struct, got %T", ptr)
+	}
+
+	w := &defaultsWalker{
+		fieldErr: NewFieldError(),
+		filling:  make(map[reflect.Type]bool),
+		visiting: make(visitSet),
+	}
+	w.visiting.enter(rv)
+	w.setDefaults(rv.Elem(), "")
+
+	if w.fieldErr.HasError() {
+		return w.fieldErr
+	}
+
+	return nil
+}
+
+// defaultsWalker holds the state of a SetDefaults call as it recurses into nested structs.
+type defaultsWalker struct {
+	fieldErr *FieldError
+
+	// filling holds the struct types being filled, to avoid allocating recursive types
+	// without end.
+	filling map[reflect.Type]bool
+
+	// visiting holds the pointers being followed, to stop at cycles.
+	visiting visitSet
+}
+
+// setDefaults sets the tagged zero fields of the settable struct rv and reports whether
+// any field was set.
+func (w *defaultsWalker) setDefaults(rv reflect.Value, prefix string) bool {
+	t := rv.Type()
+	changed := false
+
+	if !w.filling[t] {
+		w.filling[t] = true
+		defer delete(w.filling, t)
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		path := prefix + field.Name
+		fv := rv.Field(i)
+
+		tag, ok := field.Tag.Lookup("default")
+		if !ok {
+			if w.setNestedDefaults(fv, path+".") {
+				changed = true
+			}
+			continue
+		}
+
+		if !IsZeroValue(fv.Interface()) {
+			continue
+		}
+
+		v, err := parseDefault(field.Type, tag)
+		if err != nil {
+			w.fieldErr.Add(path, err)
+			continue
+		}
+
+		fv.Set(v)
+		changed = true
+	}
+
+	return changed
+}
+
+// setNestedDefaults sets the defaults of an untagged field if it is a struct or a
+// pointer to one, and reports whether any field was set.
+func (w *defaultsWalker) setNestedDefaults(fv reflect.Value, prefix string) bool {
+	switch {
+	case isNestedDefaultsStruct(fv.Type()):
+		return w.setDefaults(fv, prefix)
+	case fv.Kind() == reflect.Pointer && isNestedDefaultsStruct(fv.Type().Elem()):
+		if !fv.IsNil() {
+			if !w.visiting.enter(fv) {
+				return false
+			}
+			defer w.visiting.leave(fv)
+
+			return w.setDefaults(fv.Elem(), prefix)
+		}
+
+		if w.filling[fv.Type().Elem()] {
+			return false
+		}
+
+		nested := reflect.New(fv.Type().Elem())
+		if !w.setDefaults(nested.Elem(), prefix) {
+			return false
+		}
+
+		fv.Set(nested)
+		return true
+	}
+
+	return false
+}
+
+// isNestedDefaultsStruct reports whether values of type t are structs whose fields
+// are filled from their own tags, rather than values parsed from a single tag.
+func isNestedDefaultsStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// parseDefault parses the tag value s as a value of type t.
+func parseDefault(t reflect.Type, s string) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		err := v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+		return v, err
+	}
+
+	if t == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(int64(d))
+		return v, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return v, err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 0, t.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 0, t.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, t.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetFloat(f)
+	case reflect.Pointer:
+		elem, err := parseDefault(t.Elem(), s)
+		if err != nil {
+			return v, err
+		}
+		v.Set(reflect.New(t.Elem()))
+		v.Elem().Set(elem)
+	case reflect.Slice:
+		var parts []string
+		if s != "" {
+			parts = strings.Split(s, ",")
+		}
+
+		v.Set(reflect.MakeSlice(t, len(parts), len(parts)))
+		for i, part := range parts {
+			elem, err := parseDefault(t.Elem(), strings.TrimSpace(part))
+			if err != nil {
+				return v, fmt.Errorf("element %d: %w", i, err)
+			}
+			v.Index(i).Set(elem)
+		}
+	default:
+		return v, fmt.Errorf("unsupported type %s", t)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,385 @@
+package generics
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type defaultsTestTLS struct {
+	Enabled bool
+	Cert    string
+}
+
+type defaultsTestConfig struct {
+	Host     string
+	Port     int
+	Timeout  time.Duration
+	Started  time.Time
+	Tags     []string
+	TLS      defaultsTestTLS
+	Fallback *defaultsTestTLS
+	internal string
+}
+
+func TestApplyDefaults(t *testing.T) {
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	defaults := defaultsTestConfig{
+		Host:     "localhost",
+		Port:     8080,
+		Timeout:  time.Second,
+		Started:  started,
+		Tags:     []string{"default"},
+		TLS:      defaultsTestTLS{Enabled: true, Cert: "default.pem"},
+		Fallback: &defaultsTestTLS{Cert: "fallback.pem"},
+		internal: "default",
+	}
+
+	t.Run("zero struct", func(t *testing.T) {
+		var cfg defaultsTestConfig
+		ApplyDefaults(&cfg, defaults)
+
+		expected := defaults
+		expected.internal = ""
+
+		if !reflect.DeepEqual(cfg, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, cfg)
+		}
+	})
+
+	t.Run("partially set", func(t *testing.T) {
+		cfg := defaultsTestConfig{
+			Port:     9090,
+			Tags:     []string{},
+			TLS:      defaultsTestTLS{Cert: "custom.pem"},
+			Fallback: &defaultsTestTLS{Enabled: true},
+		}
+		ApplyDefaults(&cfg, defaults)
+
+		expected := defaultsTestConfig{
+			Host:     "localhost",
+			Port:     9090,
+			Timeout:  time.Second,
+			Started:  started,
+			Tags:     []string{},
+			TLS:      defaultsTestTLS{Enabled: true, Cert: "custom.pem"},
+			Fallback: &defaultsTestTLS{Enabled: true, Cert: "fallback.pem"},
+		}
+
+		if !reflect.DeepEqual(cfg, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, cfg)
+		}
+
+		if defaults.Fallback.Enabled {
+			t.Errorf("Expected defaults to be unchanged")
+		}
+	})
+
+	t.Run("non-struct", func(t *testing.T) {
+		n := 0
+		ApplyDefaults(&n, 5)
+		if n != 5 {
+			t.Errorf("Expected 5, got %d", n)
+		}
+
+		n = 3
+		ApplyDefaults(&n, 5)
+		if n != 3 {
+			t.Errorf("Expected 3, got %d", n)
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		ApplyDefaults(nil, defaults)
+	})
+}
+
+type defaultsTestNode struct {
+	Name  string
+	Port  int
+	Next  *defaultsTestNode
+	Other *defaultsTestNode
+}
+
+func TestApplyDefaultsCycles(t *testing.T) {
+	a := &defaultsTestNode{Port: 1}
+	a.Next = a
+
+	d := &defaultsTestNode{Name: "default", Port: 8080}
+	d.Next = d
+
+	ApplyDefaults(a, *d)
+
+	if a.Name != "default" || a.Port != 1 || a.Next != a {
+		t.Errorf("Expected a self-referencing value to be filled once, got %+v", *a)
+	}
+
+	b := &defaultsTestNode{}
+	c := &defaultsTestNode{Next: b}
+	b.Next = c
+	b.Other = c
+
+	ApplyDefaults(b, *d)
+
+	if b.Name != "default" || c.Name != "default" || c.Port != 8080 {
+		t.Errorf("Expected both values in a cycle to be filled, got %+v and %+v", *b, *c)
+	}
+}
+
+func ExampleApplyDefaults() {
+	type Config struct {
+		Host string
+		Port int
+	}
+
+	cfg := Config{Port: 9090}
+	ApplyDefaults(&cfg, Config{Host: "localhost", Port: 8080})
+
+	fmt.Printf("%+v\n", cfg)
+	// Output:
+	// {Host:localhost Port:9090}
+}
+
+type defaultsTestLevel int
+
+func (l *defaultsTestLevel) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "debug":
+		*l = 1
+	case "info":
+		*l = 2
+	default:
+		return fmt.Errorf("unknown level %q", text)
+	}
+
+	return nil
+}
+
+type defaultsTestServer struct {
+	Host string `default:"localhost"`
+	Port uint16 `default:"8080"`
+}
+
+type defaultsTestTagged struct {
+	Name     string            `default:"service"`
+	Enabled  bool              `default:"true"`
+	Retries  int               `default:"3"`
+	Ratio    float64           `default:"0.5"`
+	Timeout  time.Duration     `default:"1m30s"`
+	Started  time.Time         `default:"2024-01-02T03:04:05Z"`
+	Tags     []string          `default:"a, b,c"`
+	Ports    []int             `default:"80,443"`
+	Level    defaultsTestLevel `default:"info"`
+	Limit    *int              `default:"10"`
+	Server   defaultsTestServer
+	Backup   *defaultsTestServer
+	Optional *struct{ Name string }
+	Untagged string
+	internal string `default:"ignored"`
+}
+
+func TestSetDefaults(t *testing.T) {
+	t.Run("zero struct", func(t *testing.T) {
+		var cfg defaultsTestTagged
+		if err := SetDefaults(&cfg); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		limit := 10
+		expected := defaultsTestTagged{
+			Name:    "service",
+			Enabled: true,
+			Retries: 3,
+			Ratio:   0.5,
+			Timeout: 90 * time.Second,
+			Started: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Tags:    []string{"a", "b", "c"},
+			Ports:   []int{80, 443},
+			Level:   2,
+			Limit:   &limit,
+			Server:  defaultsTestServer{Host: "localhost", Port: 8080},
+			Backup:  &defaultsTestServer{Host: "localhost", Port: 8080},
+		}
+
+		if !reflect.DeepEqual(cfg, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, cfg)
+		}
+	})
+
+	t.Run("set fields are kept", func(t *testing.T) {
+		cfg := defaultsTestTagged{
+			Name:   "custom",
+			Tags:   []string{},
+			Server: defaultsTestServer{Port: 9090},
+			Backup: &defaultsTestServer{Host: "backup"},
+		}
+		if err := SetDefaults(&cfg); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if cfg.Name != "custom" {
+			t.Errorf("Expected Name custom, got %q", cfg.Name)
+		}
+
+		if cfg.Tags == nil || len(cfg.Tags) != 0 {
+			t.Errorf("Expected empty Tags, got %v", cfg.Tags)
+		}
+
+		if cfg.Server != (defaultsTestServer{Host: "localhost", Port: 9090}) {
+			t.Errorf("Expected Server to be filled, got %+v", cfg.Server)
+		}
+
+		if *cfg.Backup != (defaultsTestServer{Host: "backup", Port: 8080}) {
+			t.Errorf("Expected Backup to be filled, got %+v", *cfg.Backup)
+		}
+	})
+
+	t.Run("parse errors", func(t *testing.T) {
+		type invalid struct {
+			Port    int               `default:"http"`
+			Timeout time.Duration     `default:"soon"`
+			Level   defaultsTestLevel `default:"loud"`
+			Ports   []int             `default:"80,x"`
+			Server  struct {
+				Enabled bool `default:"maybe"`
+			}
+			Name string   `default:"ok"`
+			Ch   chan int `default:"1"`
+		}
+
+		var v invalid
+		err := SetDefaults(&v)
+
+		var fieldErr *FieldError
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("Expected *FieldError, got %v", err)
+		}
+
+		expected := []string{"Ch", "Level", "Port", "Ports", "Server.Enabled", "Timeout"}
+		if !reflect.DeepEqual(fieldErr.Fields(), expected) {
+			t.Errorf("Expected fields %v, got %v", expected, fieldErr.Fields())
+		}
+
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("Expected errors.Is to match strconv.ErrSyntax")
+		}
+
+		if v.Name != "ok" {
+			t.Errorf("Expected valid fields to be set, got Name %q", v.Name)
+		}
+
+		if v.Port != 0 || v.Ports != nil {
+			t.Errorf("Expected failing fields to be unchanged, got %d and %v", v.Port, v.Ports)
+		}
+	})
+
+	t.Run("invalid argument", func(t *testing.T) {
+		var cfg defaultsTestTagged
+		var nilPtr *defaultsTestTagged
+		n := 1
+
+		for _, v := range []any{cfg, nilPtr, &n, nil} {
+			if err := SetDefaults(v); err == nil {
+				t.Errorf("Expected error for %T", v)
+			}
+		}
+	})
+}
+
+type defaultsTestTree struct {
+	Name  string `default:"node"`
+	Left  *defaultsTestTree
+	Right *defaultsTestTree
+}
+
+type defaultsTestParent struct {
+	Name  string `default:"parent"`
+	Child *defaultsTestChild
+}
+
+type defaultsTestChild struct {
+	Name   string `default:"child"`
+	Parent *defaultsTestParent
+}
+
+func TestSetDefaultsRecursiveTypes(t *testing.T) {
+	t.Run("nil pointers to the same type are not allocated", func(t *testing.T) {
+		var tree defaultsTestTree
+		if err := SetDefaults(&tree); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if tree.Name != "node" || tree.Left != nil || tree.Right != nil {
+			t.Errorf("Expected only the root to be filled, got %+v", tree)
+		}
+	})
+
+	t.Run("existing nodes are filled", func(t *testing.T) {
+		tree := defaultsTestTree{Left: &defaultsTestTree{Right: &defaultsTestTree{Name: "leaf"}}}
+		if err := SetDefaults(&tree); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if tree.Name != "node" || tree.Left.Name != "node" || tree.Left.Right.Name != "leaf" {
+			t.Errorf("Expected existing nodes to be filled, got %+v", tree)
+		}
+
+		if tree.Right != nil || tree.Left.Left != nil || tree.Left.Right.Left != nil {
+			t.Errorf("Expected nil nodes to stay nil")
+		}
+	})
+
+	t.Run("mutually recursive types", func(t *testing.T) {
+		var parent defaultsTestParent
+		if err := SetDefaults(&parent); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if parent.Child == nil || parent.Child.Name != "child" || parent.Child.Parent != nil {
+			t.Errorf("Expected the child to be allocated without a new parent, got %+v", parent.Child)
+		}
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		tree := &defaultsTestTree{}
+		tree.Left = tree
+
+		other := &defaultsTestTree{Left: tree}
+		tree.Right = other
+
+		if err := SetDefaults(tree); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if tree.Name != "node" || other.Name != "node" || tree.Left != tree {
+			t.Errorf("Expected each node in the cycle to be filled, got %+v and %+v", *tree, *other)
+		}
+	})
+}
+
+func ExampleSetDefaults() {
+	type Config struct {
+		Host    string        `default:"localhost"`
+		Port    int           `default:"8080"`
+		Timeout time.Duration `default:"30s"`
+	}
+
+	cfg := Config{Port: 9090}
+	if err := SetDefaults(&cfg); err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("%+v\n", cfg)
+
+	var invalid struct {
+		Port int `default:"http"`
+	}
+	fmt.Println(SetDefaults(&invalid))
+	// Output:
+	// {Host:localhost Port:9090 Timeout:30s}
+	// 1 error: field Port: strconv.ParseInt: parsing "http": invalid syntax
+}
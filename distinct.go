@@ -0,0 +1,122 @@
+package generics
+
+import (
+	"iter"
+	"slices"
+)
+
+// Distinct returns a new slice with repeated elements removed, keeping the first
+// occurrence of each. Unlike slices.Compact, the input need not be sorted and the
+// elements keep their original order.
+func Distinct[T comparable](arr []T) []T {
+	return DistinctBy(arr, func(v T) T { return v })
+}
+
+// DistinctBy returns a new slice keeping only the first element for each key returned
+// by key, in their original order.
+func DistinctBy[T any, K comparable](arr []T, key func(T) K) []T {
+	result := make([]T, 0)
+	seen := make(map[K]struct{})
+
+	for _, v := range arr {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// DistinctFunc returns a new slice keeping only the first of the elements that eq
+// reports as equal, in their original order. It works with elements that are not
+// comparable, but compares each element with every element kept so far, so it takes
+// quadratic time in the worst case.
+func DistinctFunc[T any](arr []T, eq func(a, b T) bool) []T {
+	result := make([]T, 0)
+
+	for _, v := range arr {
+		if !slices.ContainsFunc(result, func(kept T) bool { return eq(kept, v) }) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// DistinctLast returns a new slice with repeated elements removed, keeping the last
+// occurrence of each. The kept elements are in their original order, so an element
+// appears at the position of its last occurrence.
+func DistinctLast[T comparable](arr []T) []T {
+	return DistinctByLast(arr, func(v T) T { return v })
+}
+
+// DistinctByLast returns a new slice keeping only the last element for each key
+// returned by key, in their original order.
+func DistinctByLast[T any, K comparable](arr []T, key func(T) K) []T {
+	keys := make([]K, len(arr))
+	last := make(map[K]int)
+
+	for i, v := range arr {
+		keys[i] = key(v)
+		last[keys[i]] = i
+	}
+
+	result := make([]T, 0, len(last))
+
+	for i, v := range arr {
+		if last[keys[i]] == i {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// DistinctFuncLast returns a new slice keeping only the last of the elements that eq
+// reports as equal, in their original order. Like DistinctFunc, it takes quadratic
+// time in the worst case.
+func DistinctFuncLast[T any](arr []T, eq func(a, b T) bool) []T {
+	result := make([]T, 0)
+
+	for i := len(arr) - 1; i >= 0; i-- {
+		v := arr[i]
+		if !slices.ContainsFunc(result, func(kept T) bool { return eq(kept, v) }) {
+			result = append(result, v)
+		}
+	}
+
+	slices.Reverse(result)
+
+	return result
+}
+
+// DistinctSeq returns an iterator over the values of seq with repeated values removed,
+// keeping the first occurrence of each. It is the lazy counterpart of Distinct, and
+// remembers every value it has yielded.
+func DistinctSeq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return DistinctBySeq(seq, func(v T) T { return v })
+}
+
+// DistinctBySeq returns an iterator over the values of seq keeping only the first value
+// for each key returned by key. It is the lazy counterpart of DistinctBy.
+func DistinctBySeq[T any, K comparable](seq iter.Seq[T], key func(T) K) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+
+		for v := range seq {
+			k := key(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
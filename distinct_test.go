@@ -0,0 +1,129 @@
+package generics
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type distinctTestUser struct {
+	ID    int
+	Name  string
+	Roles []string
+}
+
+func TestDistinct(t *testing.T) {
+	tests := []struct {
+		name          string
+		arr           []int
+		expectedFirst []int
+		expectedLast  []int
+	}{
+		{
+			name:          "no repeats",
+			arr:           []int{3, 1, 2},
+			expectedFirst: []int{3, 1, 2},
+			expectedLast:  []int{3, 1, 2},
+		},
+		{
+			name:          "repeats",
+			arr:           []int{1, 2, 1, 3, 2},
+			expectedFirst: []int{1, 2, 3},
+			expectedLast:  []int{1, 3, 2},
+		},
+		{
+			name:          "all equal",
+			arr:           []int{4, 4, 4},
+			expectedFirst: []int{4},
+			expectedLast:  []int{4},
+		},
+		{
+			name:          "empty",
+			arr:           []int{},
+			expectedFirst: []int{},
+			expectedLast:  []int{},
+		},
+		{
+			name:          "nil",
+			arr:           nil,
+			expectedFirst: []int{},
+			expectedLast:  []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Distinct(tt.arr); !slices.Equal(got, tt.expectedFirst) || got == nil {
+				t.Errorf("Distinct: expected %v, got %v", tt.expectedFirst, got)
+			}
+
+			if got := DistinctLast(tt.arr); !slices.Equal(got, tt.expectedLast) || got == nil {
+				t.Errorf("DistinctLast: expected %v, got %v", tt.expectedLast, got)
+			}
+		})
+	}
+}
+
+func TestDistinctBy(t *testing.T) {
+	arr := []string{"Apple", "avocado", "Banana", "blueberry", "cherry"}
+	key := func(s string) string { return strings.ToLower(s[:1]) }
+
+	if got := DistinctBy(arr, key); !slices.Equal(got, []string{"Apple", "Banana", "cherry"}) {
+		t.Errorf("DistinctBy: expected [Apple Banana cherry], got %v", got)
+	}
+
+	if got := DistinctByLast(arr, key); !slices.Equal(got, []string{"avocado", "blueberry", "cherry"}) {
+		t.Errorf("DistinctByLast: expected [avocado blueberry cherry], got %v", got)
+	}
+}
+
+func TestDistinctFunc(t *testing.T) {
+	users := []distinctTestUser{
+		{ID: 1, Name: "a", Roles: []string{"admin"}},
+		{ID: 2, Name: "b", Roles: []string{"user"}},
+		{ID: 3, Name: "c", Roles: []string{"admin"}},
+		{ID: 4, Name: "d"},
+	}
+
+	sameRoles := func(a, b distinctTestUser) bool {
+		return slices.Equal(a.Roles, b.Roles)
+	}
+
+	ids := func(us []distinctTestUser) []int {
+		return SafeMap(func(u distinctTestUser) int { return u.ID }, us)
+	}
+
+	if got := ids(DistinctFunc(users, sameRoles)); !slices.Equal(got, []int{1, 2, 4}) {
+		t.Errorf("DistinctFunc: expected [1 2 4], got %v", got)
+	}
+
+	if got := ids(DistinctFuncLast(users, sameRoles)); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Errorf("DistinctFuncLast: expected [2 3 4], got %v", got)
+	}
+}
+
+func TestDistinctSeq(t *testing.T) {
+	seq := FromSlice([]int{1, 2, 1, 3, 2, 4})
+
+	if got := Collect(DistinctSeq(seq)); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v", got)
+	}
+
+	if got := Collect(Take(DistinctSeq(seq), 2)); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", got)
+	}
+
+	parity := func(n int) int { return n % 2 }
+	if got := Collect(DistinctBySeq(seq, parity)); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", got)
+	}
+}
+
+func ExampleDistinct() {
+	fmt.Println(Distinct([]string{"b", "a", "b", "c", "a"}))
+	fmt.Println(DistinctLast([]string{"b", "a", "b", "c", "a"}))
+	// Output:
+	// [b a c]
+	// [b c a]
+}
@@ -0,0 +1,75 @@
+package generics
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// FieldError is a collection of errors caused by the fields of a struct.
+// It maps the dotted path of each field, such as "Server.Port", to its error,
+// in the same way that MapError maps the index of an element to its error.
+//
+// FieldError works with errors.Is and errors.As, which match against every
+// contained error.
+type FieldError struct {
+	Errors map[string]error
+}
+
+// NewFieldError creates a new, empty FieldError.
+func NewFieldError() *FieldError {
+	return &FieldError{
+		Errors: make(map[string]error),
+	}
+}
+
+// Error returns a string representation of the FieldError, listing each error
+// with its field in field path order.
+func (f *FieldError) Error() string {
+	var b strings.Builder
+
+	if len(f.Errors) == 1 {
+		b.WriteString("1 error")
+	} else {
+		fmt.Fprintf(&b, "%d errors", len(f.Errors))
+	}
+
+	for i, field := range f.Fields() {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		fmt.Fprintf(&b, "field %s: %v", field, f.Errors[field])
+	}
+
+	return b.String()
+}
+
+// Unwrap returns the contained errors in field path order.
+// It allows errors.Is and errors.As to inspect every error in the FieldError.
+func (f *FieldError) Unwrap() []error {
+	errs := make([]error, 0, len(f.Errors))
+
+	for _, field := range f.Fields() {
+		errs = append(errs, f.Errors[field])
+	}
+
+	return errs
+}
+
+// HasError returns true if the FieldError contains any errors.
+func (f *FieldError) HasError() bool {
+	return len(f.Errors) > 0
+}
+
+// Add adds an error to the FieldError for the field at the given path.
+func (f *FieldError) Add(field string, err error) {
+	f.Errors[field] = err
+}
+
+// Fields returns the paths of the fields that have errors, in sorted order.
+func (f *FieldError) Fields() []string {
+	return slices.Sorted(maps.Keys(f.Errors))
+}
@@ -0,0 +1,64 @@
+package generics
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestFieldErrorError(t *testing.T) {
+	tests := []struct {
+		name     string
+		errors   map[string]error
+		expected string
+	}{
+		{
+			name:     "No errors",
+			errors:   map[string]error{},
+			expected: "0 errors",
+		},
+		{
+			name:     "One error",
+			errors:   map[string]error{"Port": TestErrNotEven},
+			expected: "1 error: field Port: not even",
+		},
+		{
+			name:     "Errors sorted by field",
+			errors:   map[string]error{"Server.Port": TestErrOther, "Name": TestErrNotEven, "Server.Host": TestErrNotEven},
+			expected: "3 errors: field Name: not even; field Server.Host: not even; field Server.Port: other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &FieldError{Errors: tt.errors}
+
+			if f.Error() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, f.Error())
+			}
+		})
+	}
+}
+
+func TestFieldErrorUnwrap(t *testing.T) {
+	f := NewFieldError()
+	if f.HasError() {
+		t.Errorf("Expected no errors")
+	}
+
+	f.Add("B", TestErrOther)
+	f.Add("A", fmt.Errorf("wrapped: %w", TestErrNotEven))
+
+	if !f.HasError() {
+		t.Errorf("Expected errors")
+	}
+
+	unwrapped := f.Unwrap()
+	if len(unwrapped) != 2 || unwrapped[0] != f.Errors["A"] || unwrapped[1] != f.Errors["B"] {
+		t.Errorf("Expected errors in field order, got %v", unwrapped)
+	}
+
+	if !errors.Is(f, TestErrNotEven) || !errors.Is(f, TestErrOther) {
+		t.Errorf("Expected errors.Is to match contained errors")
+	}
+}
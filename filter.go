@@ -20,6 +20,40 @@ func Filter[A any](arr []A, predicate func(A) bool) []A {
 	return result
 }
 
+// FilterIndexed is like Filter, but the predicate also receives the index of each element.
+func FilterIndexed[A any](arr []A, predicate func(int, A) bool) []A {
+	if len(arr) == 0 {
+		return arr
+	}
+
+	result := make([]A, 0, len(arr))
+
+	for i, a := range arr {
+		if predicate(i, a) {
+			result = append(result, a)
+		}
+	}
+
+	return result
+}
+
+// Partition splits a slice into the elements that satisfy the predicate and those
+// that do not, in a single pass. Both slices keep the order of the input slice.
+func Partition[A any](arr []A, predicate func(A) bool) (matched []A, unmatched []A) {
+	matched = make([]A, 0)
+	unmatched = make([]A, 0)
+
+	for _, a := range arr {
+		if predicate(a) {
+			matched = append(matched, a)
+		} else {
+			unmatched = append(unmatched, a)
+		}
+	}
+
+	return matched, unmatched
+}
+
 // Reduce applies a function to each element in a slice, accumulating a single result.
 // It starts with the initial value and sequentially applies f to the accumulator and each element.
 func Reduce[A any, B any](arr []A, initial B, f func(B, A) B) B {
@@ -32,6 +66,47 @@ func Reduce[A any, B any](arr []A, initial B, f func(B, A) B) B {
 	return result
 }
 
+// ReduceIndexed is like Reduce, but f also receives the index of each element.
+func ReduceIndexed[A any, B any](arr []A, initial B, f func(B, int, A) B) B {
+	result := initial
+
+	for i, a := range arr {
+		result = f(result, i, a)
+	}
+
+	return result
+}
+
+// Scan is like Reduce, but returns every intermediate result rather than only the last.
+// The result has one element for each element of arr: the accumulator after applying
+// f to that element. The initial value is not included.
+func Scan[A any, B any](arr []A, initial B, f func(B, A) B) []B {
+	results := make([]B, len(arr))
+	acc := initial
+
+	for i, a := range arr {
+		acc = f(acc, a)
+		results[i] = acc
+	}
+
+	return results
+}
+
+// ScanRight is like Scan, but accumulates from the last element to the first.
+// Each result stays at the index of its element, so the first element of the result
+// is the accumulation of the whole slice and the last is f(initial, arr[len(arr)-1]).
+func ScanRight[A any, B any](arr []A, initial B, f func(B, A) B) []B {
+	results := make([]B, len(arr))
+	acc := initial
+
+	for i := len(arr) - 1; i >= 0; i-- {
+		acc = f(acc, arr[i])
+		results[i] = acc
+	}
+
+	return results
+}
+
 // Contains returns true if the slice contains an element that satisfies the predicate.
 func Contains[T any](arr []T, f func(T) bool) bool {
 	return slices.IndexFunc(arr, f) != -1
@@ -48,3 +123,14 @@ func ForEach[A any](arr []A, f func(A) error) error {
 	}
 	return nil
 }
+
+// ForEachIndexed is like ForEach, but f also receives the index of each element.
+// It stops processing as soon as an error is encountered.
+func ForEachIndexed[A any](arr []A, f func(int, A) error) error {
+	for i, a := range arr {
+		if err := f(i, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package generics
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 )
 
@@ -62,6 +63,59 @@ func TestFilter(t *testing.T) {
 	}
 }
 
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name              string
+		arr               []int
+		predicate         func(int) bool
+		expectedMatched   []int
+		expectedUnmatched []int
+	}{
+		{
+			name:              "Partition even numbers",
+			arr:               []int{1, 2, 3, 4, 5},
+			predicate:         func(a int) bool { return a%2 == 0 },
+			expectedMatched:   []int{2, 4},
+			expectedUnmatched: []int{1, 3, 5},
+		},
+		{
+			name:              "Partition all",
+			arr:               []int{1, 2, 3},
+			predicate:         func(a int) bool { return true },
+			expectedMatched:   []int{1, 2, 3},
+			expectedUnmatched: []int{},
+		},
+		{
+			name:              "Partition none",
+			arr:               []int{1, 2, 3},
+			predicate:         func(a int) bool { return false },
+			expectedMatched:   []int{},
+			expectedUnmatched: []int{1, 2, 3},
+		},
+		{
+			name:              "Partition nil array",
+			arr:               nil,
+			predicate:         func(a int) bool { return a%2 == 0 },
+			expectedMatched:   []int{},
+			expectedUnmatched: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, unmatched := Partition(tt.arr, tt.predicate)
+
+			if !slices.Equal(matched, tt.expectedMatched) {
+				t.Errorf("Expected matched %v, got %v", tt.expectedMatched, matched)
+			}
+
+			if !slices.Equal(unmatched, tt.expectedUnmatched) {
+				t.Errorf("Expected unmatched %v, got %v", tt.expectedUnmatched, unmatched)
+			}
+		})
+	}
+}
+
 func TestReduce(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -124,6 +178,72 @@ func TestReduce(t *testing.T) {
 	}
 }
 
+func TestScan(t *testing.T) {
+	sum := func(acc, val int) int { return acc + val }
+
+	tests := []struct {
+		name          string
+		arr           []int
+		initial       int
+		expected      []int
+		expectedRight []int
+	}{
+		{
+			name:          "Running sum",
+			arr:           []int{1, 2, 3, 4},
+			initial:       0,
+			expected:      []int{1, 3, 6, 10},
+			expectedRight: []int{10, 9, 7, 4},
+		},
+		{
+			name:          "With initial",
+			arr:           []int{1, 2, 3},
+			initial:       10,
+			expected:      []int{11, 13, 16},
+			expectedRight: []int{16, 15, 13},
+		},
+		{
+			name:          "Empty array",
+			arr:           []int{},
+			initial:       42,
+			expected:      []int{},
+			expectedRight: []int{},
+		},
+		{
+			name:          "Nil array",
+			arr:           nil,
+			initial:       42,
+			expected:      []int{},
+			expectedRight: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Scan(tt.arr, tt.initial, sum); !slices.Equal(result, tt.expected) {
+				t.Errorf("Scan: expected %v, got %v", tt.expected, result)
+			}
+
+			if result := ScanRight(tt.arr, tt.initial, sum); !slices.Equal(result, tt.expectedRight) {
+				t.Errorf("ScanRight: expected %v, got %v", tt.expectedRight, result)
+			}
+		})
+	}
+
+	t.Run("Order of application", func(t *testing.T) {
+		concat := func(acc string, val string) string { return acc + val }
+		arr := []string{"a", "b", "c"}
+
+		if result := Scan(arr, "", concat); !slices.Equal(result, []string{"a", "ab", "abc"}) {
+			t.Errorf("Scan: expected [a ab abc], got %v", result)
+		}
+
+		if result := ScanRight(arr, "", concat); !slices.Equal(result, []string{"cba", "cb", "c"}) {
+			t.Errorf("ScanRight: expected [cba cb c], got %v", result)
+		}
+	})
+}
+
 func TestContains(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -248,6 +368,64 @@ func TestForEach(t *testing.T) {
 
 // Examples
 
+func TestFilterIndexed(t *testing.T) {
+	arr := []string{"a", "b", "c", "d", "e"}
+
+	evenPositions := FilterIndexed(arr, func(i int, _ string) bool {
+		return i%2 == 0
+	})
+
+	if !slices.Equal(evenPositions, []string{"a", "c", "e"}) {
+		t.Errorf("Expected [a c e], got %v", evenPositions)
+	}
+
+	if result := FilterIndexed([]string{}, func(int, string) bool { return true }); result == nil || len(result) != 0 {
+		t.Errorf("Expected empty slice, got %v", result)
+	}
+
+	if result := FilterIndexed[string](nil, func(int, string) bool { return true }); result != nil {
+		t.Errorf("Expected nil, got %v", result)
+	}
+}
+
+func TestReduceIndexed(t *testing.T) {
+	arr := []int{5, 6, 7}
+
+	weighted := ReduceIndexed(arr, 0, func(acc int, i int, a int) int {
+		return acc + i*a
+	})
+
+	if weighted != 20 {
+		t.Errorf("Expected 20, got %v", weighted)
+	}
+
+	if result := ReduceIndexed(nil, 42, func(acc int, i int, a int) int { return acc + a }); result != 42 {
+		t.Errorf("Expected 42, got %v", result)
+	}
+}
+
+func TestForEachIndexed(t *testing.T) {
+	arr := []int{10, 20, 30, 40}
+	var seen []int
+	expectedErr := errors.New("test error")
+
+	err := ForEachIndexed(arr, func(i int, a int) error {
+		seen = append(seen, i)
+		if a == 30 {
+			return expectedErr
+		}
+		return nil
+	})
+
+	if err != expectedErr {
+		t.Errorf("Expected error %v, got %v", expectedErr, err)
+	}
+
+	if !slices.Equal(seen, []int{0, 1, 2}) {
+		t.Errorf("Expected indices [0 1 2], got %v", seen)
+	}
+}
+
 func ExampleFilter() {
 	arr := []int{1, 2, 3, 4, 5}
 	filtered := Filter(arr, func(a int) bool {
@@ -258,6 +436,16 @@ func ExampleFilter() {
 	// Output: [2 4]
 }
 
+func ExamplePartition() {
+	arr := []int{1, 2, 3, 4, 5}
+	evens, odds := Partition(arr, func(a int) bool {
+		return a%2 == 0
+	})
+
+	fmt.Println(evens, odds)
+	// Output: [2 4] [1 3 5]
+}
+
 func ExampleReduce() {
 	arr := []int{1, 2, 3, 4, 5}
 	sum := Reduce(arr, 0, func(acc int, a int) int {
@@ -268,6 +456,16 @@ func ExampleReduce() {
 	// Output: 15
 }
 
+func ExampleScan() {
+	dailySignups := []int{3, 5, 2, 4}
+	cumulative := Scan(dailySignups, 0, func(acc int, a int) int {
+		return acc + a
+	})
+
+	fmt.Println(cumulative)
+	// Output: [3 8 10 14]
+}
+
 func ExampleContains() {
 	arr := []int{1, 2, 3, 4, 5}
 	hasEven := Contains(arr, func(a int) bool {
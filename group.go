@@ -0,0 +1,134 @@
+package generics
+
+import "fmt"
+
+// DuplicatePolicy controls what KeyBy does when two elements map to the same key.
+type DuplicatePolicy int
+
+const (
+	// KeepFirst keeps the first element for each key.
+	KeepFirst DuplicatePolicy = iota
+
+	// KeepLast keeps the last element for each key.
+	KeepLast
+
+	// ErrorOnDuplicate keeps the first element for each key and reports every
+	// later element with the same key as an error.
+	ErrorOnDuplicate
+)
+
+// GroupBy groups the elements of a slice by the key returned by f.
+// Within each group, elements keep their order from the input slice.
+func GroupBy[T any, K comparable](arr []T, f func(T) K) map[K][]T {
+	result := make(map[K][]T)
+
+	for _, v := range arr {
+		k := f(v)
+		result[k] = append(result[k], v)
+	}
+
+	return result
+}
+
+// GroupByOrdered groups the elements of a slice by the key returned by f, like GroupBy,
+// but returns the groups as Pairs of key and elements, ordered by the first
+// appearance of each key in the input slice.
+func GroupByOrdered[T any, K comparable](arr []T, f func(T) K) []Pair[K, []T] {
+	return AggregateByOrdered(arr, f, nil, func(group []T, v T) []T {
+		return append(group, v)
+	})
+}
+
+// KeyBy indexes the elements of a slice by the key returned by f.
+//
+// When several elements have the same key, policy decides which is kept.
+// With ErrorOnDuplicate, the first element is kept and a MapError is returned
+// holding an error wrapping ErrDuplicateKey for the index of every later element
+// with the same key. Otherwise, the error is always nil.
+func KeyBy[T any, K comparable](arr []T, f func(T) K, policy DuplicatePolicy) (map[K]T, error) {
+	result := make(map[K]T, len(arr))
+	err := NewMapError()
+
+	for i, v := range arr {
+		k := f(v)
+
+		if _, exists := result[k]; exists {
+			switch policy {
+			case KeepFirst:
+				continue
+			case ErrorOnDuplicate:
+				err.Add(i, fmt.Errorf("%w: %v", ErrDuplicateKey, k))
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	if err.HasError() {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// CountBy counts the elements of a slice by the key returned by f.
+func CountBy[T any, K comparable](arr []T, f func(T) K) map[K]int {
+	return AggregateBy(arr, f, 0, func(count int, _ T) int {
+		return count + 1
+	})
+}
+
+// CountByOrdered counts the elements of a slice by the key returned by f, like CountBy,
+// but returns the counts as Pairs of key and count, ordered by the first
+// appearance of each key in the input slice.
+func CountByOrdered[T any, K comparable](arr []T, f func(T) K) []Pair[K, int] {
+	return AggregateByOrdered(arr, f, 0, func(count int, _ T) int {
+		return count + 1
+	})
+}
+
+// AggregateBy groups the elements of a slice by the key returned by key and reduces
+// each group to a single result. Each group starts with the initial value, and f is
+// applied to the accumulator and each element in input order, as with Reduce.
+//
+// The initial value is shared by every group, so f must not modify it in place.
+func AggregateBy[T any, K comparable, B any](arr []T, key func(T) K, initial B, f func(B, T) B) map[K]B {
+	result := make(map[K]B)
+
+	for _, v := range arr {
+		k := key(v)
+
+		acc, exists := result[k]
+		if !exists {
+			acc = initial
+		}
+
+		result[k] = f(acc, v)
+	}
+
+	return result
+}
+
+// AggregateByOrdered reduces groups of elements like AggregateBy, but returns the
+// results as Pairs of key and result, ordered by the first appearance of each key
+// in the input slice.
+func AggregateByOrdered[T any, K comparable, B any](arr []T, key func(T) K, initial B, f func(B, T) B) []Pair[K, B] {
+	result := make([]Pair[K, B], 0)
+	positions := make(map[K]int)
+
+	for _, v := range arr {
+		k := key(v)
+
+		pos, exists := positions[k]
+		if !exists {
+			pos = len(result)
+			positions[k] = pos
+			result = append(result, Pair[K, B]{A: k, B: initial})
+		}
+
+		result[pos].B = f(result[pos].B, v)
+	}
+
+	return result
+}
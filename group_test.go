@@ -0,0 +1,229 @@
+package generics
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+type groupTestItem struct {
+	Name string
+	Kind string
+	Size int
+}
+
+var groupTestItems = []groupTestItem{
+	{Name: "apple", Kind: "fruit", Size: 3},
+	{Name: "carrot", Kind: "vegetable", Size: 2},
+	{Name: "banana", Kind: "fruit", Size: 4},
+	{Name: "leek", Kind: "vegetable", Size: 5},
+	{Name: "cherry", Kind: "fruit", Size: 1},
+	{Name: "thyme", Kind: "herb", Size: 1},
+}
+
+func groupTestKind(i groupTestItem) string {
+	return i.Kind
+}
+
+func groupTestNames(items []groupTestItem) []string {
+	return SafeMap(func(i groupTestItem) string { return i.Name }, items)
+}
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		arr      []groupTestItem
+		expected map[string][]string
+	}{
+		{
+			name: "Groups preserve order",
+			arr:  groupTestItems,
+			expected: map[string][]string{
+				"fruit":     {"apple", "banana", "cherry"},
+				"vegetable": {"carrot", "leek"},
+				"herb":      {"thyme"},
+			},
+		},
+		{
+			name:     "Empty array",
+			arr:      []groupTestItem{},
+			expected: map[string][]string{},
+		},
+		{
+			name:     "Nil array",
+			arr:      nil,
+			expected: map[string][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := GroupBy(tt.arr, groupTestKind)
+
+			if len(groups) != len(tt.expected) {
+				t.Errorf("Expected %d groups, got %d", len(tt.expected), len(groups))
+			}
+
+			for k, expected := range tt.expected {
+				if got := groupTestNames(groups[k]); !slices.Equal(got, expected) {
+					t.Errorf("Expected group %s==%v, got %v", k, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	groups := GroupByOrdered(groupTestItems, groupTestKind)
+
+	expectedKeys := []string{"fruit", "vegetable", "herb"}
+	expectedNames := [][]string{{"apple", "banana", "cherry"}, {"carrot", "leek"}, {"thyme"}}
+
+	if len(groups) != len(expectedKeys) {
+		t.Fatalf("Expected %d groups, got %d", len(expectedKeys), len(groups))
+	}
+
+	for i, g := range groups {
+		if g.A != expectedKeys[i] {
+			t.Errorf("Expected key %s at %d, got %s", expectedKeys[i], i, g.A)
+		}
+
+		if got := groupTestNames(g.B); !slices.Equal(got, expectedNames[i]) {
+			t.Errorf("Expected group %s==%v, got %v", g.A, expectedNames[i], got)
+		}
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        DuplicatePolicy
+		expected      map[string]string
+		expectedError []int
+	}{
+		{
+			name:     "Keep first",
+			policy:   KeepFirst,
+			expected: map[string]string{"fruit": "apple", "vegetable": "carrot", "herb": "thyme"},
+		},
+		{
+			name:     "Keep last",
+			policy:   KeepLast,
+			expected: map[string]string{"fruit": "cherry", "vegetable": "leek", "herb": "thyme"},
+		},
+		{
+			name:          "Error on duplicate",
+			policy:        ErrorOnDuplicate,
+			expected:      map[string]string{"fruit": "apple", "vegetable": "carrot", "herb": "thyme"},
+			expectedError: []int{2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyed, err := KeyBy(groupTestItems, groupTestKind, tt.policy)
+
+			if len(keyed) != len(tt.expected) {
+				t.Errorf("Expected %d keys, got %d", len(tt.expected), len(keyed))
+			}
+
+			for k, name := range tt.expected {
+				if keyed[k].Name != name {
+					t.Errorf("Expected %s==%s, got %s", k, name, keyed[k].Name)
+				}
+			}
+
+			if tt.expectedError == nil {
+				if err != nil {
+					t.Errorf("Expected nil, got %v", err)
+				}
+				return
+			}
+
+			var mapError *MapError
+			if !errors.As(err, &mapError) {
+				t.Fatalf("Expected *MapError, got %v", err)
+			}
+
+			if got := mapError.Indices(); !slices.Equal(got, tt.expectedError) {
+				t.Errorf("Expected errors at %v, got %v", tt.expectedError, got)
+			}
+
+			if mapError.Count(ErrDuplicateKey) != len(tt.expectedError) {
+				t.Errorf("Expected every error to wrap ErrDuplicateKey, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	counts := CountBy(groupTestItems, groupTestKind)
+	expected := map[string]int{"fruit": 3, "vegetable": 2, "herb": 1}
+
+	if len(counts) != len(expected) {
+		t.Errorf("Expected %v, got %v", expected, counts)
+	}
+
+	for k, v := range expected {
+		if counts[k] != v {
+			t.Errorf("Expected %s==%d, got %d", k, v, counts[k])
+		}
+	}
+
+	ordered := CountByOrdered(groupTestItems, groupTestKind)
+	expectedOrdered := []Pair[string, int]{{"fruit", 3}, {"vegetable", 2}, {"herb", 1}}
+
+	if !slices.Equal(ordered, expectedOrdered) {
+		t.Errorf("Expected %v, got %v", expectedOrdered, ordered)
+	}
+}
+
+func TestAggregateBy(t *testing.T) {
+	sum := func(acc int, i groupTestItem) int { return acc + i.Size }
+
+	totals := AggregateBy(groupTestItems, groupTestKind, 100, sum)
+	expected := map[string]int{"fruit": 108, "vegetable": 107, "herb": 101}
+
+	for k, v := range expected {
+		if totals[k] != v {
+			t.Errorf("Expected %s==%d, got %d", k, v, totals[k])
+		}
+	}
+
+	ordered := AggregateByOrdered(groupTestItems, groupTestKind, 100, sum)
+	expectedOrdered := []Pair[string, int]{{"fruit", 108}, {"vegetable", 107}, {"herb", 101}}
+
+	if !slices.Equal(ordered, expectedOrdered) {
+		t.Errorf("Expected %v, got %v", expectedOrdered, ordered)
+	}
+
+	if got := AggregateByOrdered(nil, groupTestKind, 0, sum); len(got) != 0 {
+		t.Errorf("Expected no groups, got %v", got)
+	}
+}
+
+func ExampleGroupByOrdered() {
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	groups := GroupByOrdered(words, func(w string) byte {
+		return w[0]
+	})
+
+	for _, g := range groups {
+		fmt.Println(string(g.A), g.B)
+	}
+	// Output:
+	// a [apple avocado]
+	// b [banana blueberry]
+	// c [cherry]
+}
+
+func ExampleKeyBy() {
+	words := []string{"apple", "avocado", "banana"}
+	_, err := KeyBy(words, func(w string) string {
+		return w[:1]
+	}, ErrorOnDuplicate)
+
+	fmt.Println(err)
+	// Output: 1 error: index 1: duplicate key: a
+}
@@ -0,0 +1,375 @@
+package generics
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MapError is a collection of errors returned by functions applied to slices.
+// It maps the index of the element that caused the error to the error itself.
+//
+// MapError works with errors.Is and errors.As, which match against every
+// contained error. It can be marshalled to JSON and implements slog.LogValuer;
+// use WithFormat to control how it is rendered.
+type MapError struct {
+	Errors map[int]error
+}
+
+// Error returns a string representation of the MapError, listing each error
+// with its index in ascending index order.
+func (m *MapError) Error() string {
+	var b strings.Builder
+
+	if len(m.Errors) == 1 {
+		b.WriteString("1 error")
+	} else {
+		fmt.Fprintf(&b, "%d errors", len(m.Errors))
+	}
+
+	for i, idx := range m.Indices() {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		fmt.Fprintf(&b, "index %d: %v", idx, m.Errors[idx])
+	}
+
+	return b.String()
+}
+
+// Unwrap returns the contained errors in ascending index order.
+// It allows errors.Is and errors.As to inspect every error in the MapError.
+func (m *MapError) Unwrap() []error {
+	errs := make([]error, 0, len(m.Errors))
+
+	for _, idx := range m.Indices() {
+		errs = append(errs, m.Errors[idx])
+	}
+
+	return errs
+}
+
+// HasError returns true if the MapError contains any errors.
+func (m *MapError) HasError() bool {
+	return len(m.Errors) > 0
+}
+
+// Add adds an error to the MapError at the specified index.
+func (m *MapError) Add(idx int, err error) {
+	m.Errors[idx] = err
+}
+
+// Indices returns the indices that have errors, in ascending order.
+func (m *MapError) Indices() []int {
+	indices := make([]int, 0, len(m.Errors))
+
+	for idx := range m.Errors {
+		indices = append(indices, idx)
+	}
+
+	slices.Sort(indices)
+
+	return indices
+}
+
+// Range calls f for each error in ascending index order.
+// If f returns false, Range stops the iteration.
+func (m *MapError) Range(f func(idx int, err error) bool) {
+	for _, idx := range m.Indices() {
+		if !f(idx, m.Errors[idx]) {
+			return
+		}
+	}
+}
+
+// Count returns the number of contained errors that match target according to errors.Is.
+func (m *MapError) Count(target error) int {
+	count := 0
+
+	for _, err := range m.Errors {
+		if errors.Is(err, target) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Skipped returns the indices whose error is a SkippedError, meaning the element was
+// not processed because its context was done, in ascending order.
+func (m *MapError) Skipped() []int {
+	indices := make([]int, 0)
+
+	m.Range(func(idx int, err error) bool {
+		if errors.Is(err, ErrSkipped) {
+			indices = append(indices, idx)
+		}
+		return true
+	})
+
+	return indices
+}
+
+// Failures returns a new MapError holding only the errors that are not a
+// SkippedError, that is the errors of elements that were processed and failed.
+func (m *MapError) Failures() *MapError {
+	result := NewMapError()
+
+	for idx, err := range m.Errors {
+		if !errors.Is(err, ErrSkipped) {
+			result.Add(idx, err)
+		}
+	}
+
+	return result
+}
+
+// Join converts the MapError into an error as returned by errors.Join.
+// Each error is wrapped in an IndexedError so that the index is preserved,
+// and the errors are joined in ascending index order.
+// If the MapError contains no errors, Join returns nil.
+func (m *MapError) Join() error {
+	errs := make([]error, 0, len(m.Errors))
+
+	m.Range(func(idx int, err error) bool {
+		errs = append(errs, &IndexedError{Index: idx, Err: err})
+		return true
+	})
+
+	return errors.Join(errs...)
+}
+
+// NewMapError creates a new, empty MapError.
+func NewMapError() *MapError {
+	return &MapError{
+		Errors: make(map[int]error),
+	}
+}
+
+// MapErrorFromJoined creates a MapError from an error returned by errors.Join,
+// or any other error with an Unwrap() []error method.
+//
+// Errors that are IndexedErrors, such as those produced by MapError.Join, keep their
+// index. Any other error is keyed by its position in the joined error.
+// A nil error results in an empty MapError, and an error that does not wrap multiple
+// errors is stored at its position, index 0.
+func MapErrorFromJoined(err error) *MapError {
+	m := NewMapError()
+
+	if err == nil {
+		return m
+	}
+
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+
+	for i, e := range errs {
+		var indexed *IndexedError
+		if errors.As(e, &indexed) {
+			m.Add(indexed.Index, indexed.Err)
+		} else {
+			m.Add(i, e)
+		}
+	}
+
+	return m
+}
+
+// IndexedError associates an error with the index of the element that caused it.
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+// Error returns a string representation of the IndexedError.
+func (e *IndexedError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *IndexedError) Unwrap() error {
+	return e.Err
+}
+
+// MapErrorFormat controls how a MapError is rendered as JSON or as a log value.
+//
+// The JSON form is an object holding the total number of errors and the error
+// messages keyed by index:
+//
+//	{"count":2,"errors":{"0":"not even","2":"not even"}}
+//
+// With IncludeTypes each message is replaced by an object holding the message and
+// the Go type name of the error:
+//
+//	{"count":1,"errors":{"0":{"message":"not even","type":"*errors.errorString"}}}
+//
+// When the number of errors exceeds Limit, only the errors with the lowest indices
+// are rendered and the number omitted is reported as "truncated".
+type MapErrorFormat struct {
+	// IncludeTypes adds the Go type name of each error.
+	IncludeTypes bool
+
+	// Limit is the maximum number of errors rendered. Zero or less means no limit.
+	Limit int
+}
+
+// FormattedMapError is a MapError rendered as JSON and log values with a specific
+// MapErrorFormat. It is created with MapError.WithFormat.
+type FormattedMapError struct {
+	*MapError
+	Format MapErrorFormat
+}
+
+// WithFormat returns the MapError wrapped so that it is rendered according to f.
+func (m *MapError) WithFormat(f MapErrorFormat) FormattedMapError {
+	return FormattedMapError{MapError: m, Format: f}
+}
+
+// MarshalJSON encodes the MapError using the default MapErrorFormat.
+func (m *MapError) MarshalJSON() ([]byte, error) {
+	return m.WithFormat(MapErrorFormat{}).MarshalJSON()
+}
+
+// UnmarshalJSON decodes a MapError encoded with any MapErrorFormat.
+// Each decoded error is a *DecodedError holding the original message and,
+// if present, the original type name.
+func (m *MapError) UnmarshalJSON(data []byte) error {
+	var encoded struct {
+		Errors map[int]json.RawMessage `json:"errors"`
+	}
+
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+
+	m.Errors = make(map[int]error, len(encoded.Errors))
+
+	for idx, raw := range encoded.Errors {
+		decoded := &DecodedError{}
+
+		if err := json.Unmarshal(raw, &decoded.Message); err != nil {
+			if err := json.Unmarshal(raw, decoded); err != nil {
+				return fmt.Errorf("error at index %d: %w", idx, err)
+			}
+		}
+
+		m.Errors[idx] = decoded
+	}
+
+	return nil
+}
+
+// LogValue renders the MapError as a group using the default MapErrorFormat.
+func (m *MapError) LogValue() slog.Value {
+	return m.WithFormat(MapErrorFormat{}).LogValue()
+}
+
+// encodedError is the JSON form of an error when type names are included.
+type encodedError struct {
+	Message string `json:"message"`
+	Type    string `json:"type,omitempty"`
+}
+
+// errorMessage returns the message of err. A nil error, which Add accepts, is
+// rendered as "<nil>" just as in Error.
+func errorMessage(err error) string {
+	return fmt.Sprint(err)
+}
+
+// errorType returns the Go type name of err, or an empty string if err is nil, in
+// which case the type is left out.
+func errorType(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%T", err)
+}
+
+// visible returns the indices to render and the number of errors left out.
+func (f FormattedMapError) visible() ([]int, int) {
+	indices := f.Indices()
+
+	if f.Format.Limit > 0 && len(indices) > f.Format.Limit {
+		return indices[:f.Format.Limit], len(indices) - f.Format.Limit
+	}
+
+	return indices, 0
+}
+
+// MarshalJSON encodes the MapError according to the format.
+func (f FormattedMapError) MarshalJSON() ([]byte, error) {
+	indices, truncated := f.visible()
+
+	errs := make(map[int]any, len(indices))
+	for _, idx := range indices {
+		err := f.Errors[idx]
+		if f.Format.IncludeTypes {
+			errs[idx] = encodedError{Message: errorMessage(err), Type: errorType(err)}
+		} else {
+			errs[idx] = errorMessage(err)
+		}
+	}
+
+	return json.Marshal(struct {
+		Count     int         `json:"count"`
+		Errors    map[int]any `json:"errors"`
+		Truncated int         `json:"truncated,omitempty"`
+	}{
+		Count:     len(f.Errors),
+		Errors:    errs,
+		Truncated: truncated,
+	})
+}
+
+// LogValue renders the MapError as a group according to the format.
+// The group holds the total count, one attribute per error keyed by index and,
+// if errors were left out, the number truncated.
+func (f FormattedMapError) LogValue() slog.Value {
+	indices, truncated := f.visible()
+
+	attrs := make([]slog.Attr, 0, len(indices)+2)
+	attrs = append(attrs, slog.Int("count", len(f.Errors)))
+
+	for _, idx := range indices {
+		err := f.Errors[idx]
+		key := strconv.Itoa(idx)
+		if f.Format.IncludeTypes {
+			group := []any{slog.String("message", errorMessage(err))}
+			if typ := errorType(err); typ != "" {
+				group = append(group, slog.String("type", typ))
+			}
+			attrs = append(attrs, slog.Group(key, group...))
+		} else {
+			attrs = append(attrs, slog.String(key, errorMessage(err)))
+		}
+	}
+
+	if truncated > 0 {
+		attrs = append(attrs, slog.Int("truncated", truncated))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// DecodedError is an error restored from the JSON form of a MapError.
+type DecodedError struct {
+	// Message is the message of the original error.
+	Message string `json:"message"`
+
+	// Type is the Go type name of the original error, if it was encoded.
+	Type string `json:"type,omitempty"`
+}
+
+// Error returns the message of the original error.
+func (e *DecodedError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,419 @@
+package generics
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+var (
+	TestErrOther = errors.New("other")
+)
+
+func TestMapErrorError(t *testing.T) {
+	tests := []struct {
+		name     string
+		errors   map[int]error
+		expected string
+	}{
+		{
+			name:     "No errors",
+			errors:   map[int]error{},
+			expected: "0 errors",
+		},
+		{
+			name:     "One error",
+			errors:   map[int]error{3: TestErrNotEven},
+			expected: "1 error: index 3: not even",
+		},
+		{
+			name:     "Errors sorted by index",
+			errors:   map[int]error{10: TestErrOther, 2: TestErrNotEven, 0: TestErrNotEven},
+			expected: "3 errors: index 0: not even; index 2: not even; index 10: other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MapError{Errors: tt.errors}
+
+			if m.Error() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, m.Error())
+			}
+		})
+	}
+}
+
+func TestMapErrorUnwrap(t *testing.T) {
+	m := NewMapError()
+	m.Add(4, TestErrOther)
+	m.Add(1, fmt.Errorf("wrapped: %w", TestErrNotEven))
+	m.Add(2, TestErrNotEven)
+
+	unwrapped := m.Unwrap()
+	expected := []error{m.Errors[1], m.Errors[2], m.Errors[4]}
+
+	if len(unwrapped) != len(expected) {
+		t.Fatalf("Expected %d errors, got %d", len(expected), len(unwrapped))
+	}
+
+	for i, err := range unwrapped {
+		if err != expected[i] {
+			t.Errorf("Expected unwrapped[%d]==%v, got %v", i, expected[i], err)
+		}
+	}
+
+	var err error = m
+	if !errors.Is(err, TestErrNotEven) {
+		t.Errorf("Expected errors.Is to match TestErrNotEven")
+	}
+
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected errors.Is not to match ErrNotFound")
+	}
+
+	var indexed *IndexedError
+	m.Add(0, &IndexedError{Index: 7, Err: TestErrOther})
+	if !errors.As(err, &indexed) || indexed.Index != 7 {
+		t.Errorf("Expected errors.As to find IndexedError, got %v", indexed)
+	}
+}
+
+func TestMapErrorFromMap(t *testing.T) {
+	_, err := Map(func(a int) (int, error) {
+		if a%2 == 0 {
+			return a, nil
+		}
+		return 0, fmt.Errorf("value %d: %w", a, ErrNotFound)
+	}, []int{1, 2, 3})
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected errors.Is to match ErrNotFound, got %v", err)
+	}
+}
+
+func TestMapErrorIndicesAndRange(t *testing.T) {
+	m := NewMapError()
+	m.Add(5, TestErrNotEven)
+	m.Add(1, TestErrOther)
+	m.Add(3, TestErrNotEven)
+
+	indices := m.Indices()
+	expected := []int{1, 3, 5}
+
+	for i, idx := range indices {
+		if idx != expected[i] {
+			t.Errorf("Expected indices[%d]==%d, got %d", i, expected[i], idx)
+		}
+	}
+
+	var visited []int
+	m.Range(func(idx int, err error) bool {
+		visited = append(visited, idx)
+		return idx < 3
+	})
+
+	if len(visited) != 2 || visited[0] != 1 || visited[1] != 3 {
+		t.Errorf("Expected to visit [1 3], got %v", visited)
+	}
+}
+
+func TestMapErrorCount(t *testing.T) {
+	m := NewMapError()
+	m.Add(0, TestErrNotEven)
+	m.Add(1, fmt.Errorf("wrapped: %w", TestErrNotEven))
+	m.Add(2, TestErrOther)
+
+	tests := []struct {
+		name     string
+		target   error
+		expected int
+	}{
+		{name: "Wrapped and unwrapped", target: TestErrNotEven, expected: 2},
+		{name: "Single", target: TestErrOther, expected: 1},
+		{name: "None", target: ErrNotFound, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if count := m.Count(tt.target); count != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, count)
+			}
+		})
+	}
+}
+
+func TestMapErrorJoin(t *testing.T) {
+	t.Run("Round trip", func(t *testing.T) {
+		m := NewMapError()
+		m.Add(2, TestErrNotEven)
+		m.Add(7, TestErrOther)
+
+		joined := m.Join()
+
+		if !errors.Is(joined, TestErrOther) {
+			t.Errorf("Expected joined error to match TestErrOther")
+		}
+
+		expectedMessage := "index 2: not even\nindex 7: other"
+		if joined.Error() != expectedMessage {
+			t.Errorf("Expected %q, got %q", expectedMessage, joined.Error())
+		}
+
+		restored := MapErrorFromJoined(joined)
+		if len(restored.Errors) != 2 || restored.Errors[2] != TestErrNotEven || restored.Errors[7] != TestErrOther {
+			t.Errorf("Expected original errors, got %v", restored.Errors)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if err := NewMapError().Join(); err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+	})
+
+	t.Run("From plain errors.Join", func(t *testing.T) {
+		restored := MapErrorFromJoined(errors.Join(TestErrNotEven, TestErrOther))
+
+		if restored.Errors[0] != TestErrNotEven || restored.Errors[1] != TestErrOther {
+			t.Errorf("Expected errors keyed by position, got %v", restored.Errors)
+		}
+	})
+
+	t.Run("From single error", func(t *testing.T) {
+		restored := MapErrorFromJoined(TestErrOther)
+
+		if len(restored.Errors) != 1 || restored.Errors[0] != TestErrOther {
+			t.Errorf("Expected single error at index 0, got %v", restored.Errors)
+		}
+	})
+
+	t.Run("From nil", func(t *testing.T) {
+		if MapErrorFromJoined(nil).HasError() {
+			t.Errorf("Expected empty MapError")
+		}
+	})
+}
+
+func TestMapErrorMarshalJSON(t *testing.T) {
+	m := NewMapError()
+	m.Add(0, TestErrNotEven)
+	m.Add(2, TestErrOther)
+	m.Add(5, TestErrNotEven)
+
+	withNil := NewMapError()
+	withNil.Add(0, nil)
+	withNil.Add(1, TestErrOther)
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{
+			name:     "Default",
+			value:    m,
+			expected: `{"count":3,"errors":{"0":"not even","2":"other","5":"not even"}}`,
+		},
+		{
+			name:     "With types",
+			value:    m.WithFormat(MapErrorFormat{IncludeTypes: true, Limit: 1}),
+			expected: `{"count":3,"errors":{"0":{"message":"not even","type":"*errors.errorString"}},"truncated":2}`,
+		},
+		{
+			name:     "Truncated",
+			value:    m.WithFormat(MapErrorFormat{Limit: 2}),
+			expected: `{"count":3,"errors":{"0":"not even","2":"other"},"truncated":1}`,
+		},
+		{
+			name:     "Limit larger than count",
+			value:    m.WithFormat(MapErrorFormat{Limit: 10}),
+			expected: `{"count":3,"errors":{"0":"not even","2":"other","5":"not even"}}`,
+		},
+		{
+			name:     "Nested in struct",
+			value:    struct{ Err *MapError }{Err: m},
+			expected: `{"Err":{"count":3,"errors":{"0":"not even","2":"other","5":"not even"}}}`,
+		},
+		{
+			name:     "Nil error",
+			value:    withNil,
+			expected: `{"count":2,"errors":{"0":"\u003cnil\u003e","1":"other"}}`,
+		},
+		{
+			name:     "Nil error with types",
+			value:    withNil.WithFormat(MapErrorFormat{IncludeTypes: true}),
+			expected: `{"count":2,"errors":{"0":{"message":"\u003cnil\u003e"},"1":{"message":"other","type":"*errors.errorString"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Expected nil, got %v", err)
+			}
+
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+func TestMapErrorUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         string
+		expected     map[int]DecodedError
+		expectedFail bool
+	}{
+		{
+			name:     "Messages",
+			data:     `{"count":2,"errors":{"0":"not even","12":"other"}}`,
+			expected: map[int]DecodedError{0: {Message: "not even"}, 12: {Message: "other"}},
+		},
+		{
+			name: "With types",
+			data: `{"count":1,"errors":{"3":{"message":"not even","type":"*errors.errorString"}}}`,
+			expected: map[int]DecodedError{
+				3: {Message: "not even", Type: "*errors.errorString"},
+			},
+		},
+		{
+			name:         "Invalid error",
+			data:         `{"errors":{"0":42}}`,
+			expectedFail: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m MapError
+			err := json.Unmarshal([]byte(tt.data), &m)
+
+			if tt.expectedFail {
+				if err == nil {
+					t.Errorf("Expected error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %v", err)
+			}
+
+			if len(m.Errors) != len(tt.expected) {
+				t.Errorf("Expected %d errors, got %d", len(tt.expected), len(m.Errors))
+			}
+
+			for idx, expected := range tt.expected {
+				var decoded *DecodedError
+				if !errors.As(m.Errors[idx], &decoded) || *decoded != expected {
+					t.Errorf("Expected %v at index %d, got %v", expected, idx, m.Errors[idx])
+				}
+			}
+		})
+	}
+}
+
+func TestMapErrorLogValue(t *testing.T) {
+	m := NewMapError()
+	m.Add(1, TestErrNotEven)
+	m.Add(4, TestErrOther)
+
+	withNil := NewMapError()
+	withNil.Add(0, nil)
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{
+			name:     "Default",
+			value:    m,
+			expected: `err.count=2 err.1="not even" err.4=other`,
+		},
+		{
+			name:     "With types",
+			value:    m.WithFormat(MapErrorFormat{IncludeTypes: true}),
+			expected: `err.count=2 err.1.message="not even" err.1.type=*errors.errorString err.4.message=other err.4.type=*errors.errorString`,
+		},
+		{
+			name:     "Truncated",
+			value:    m.WithFormat(MapErrorFormat{Limit: 1}),
+			expected: `err.count=2 err.1="not even" err.truncated=1`,
+		},
+		{
+			name:     "Nil error",
+			value:    withNil,
+			expected: `err.count=1 err.0=<nil>`,
+		},
+		{
+			name:     "Nil error with types",
+			value:    withNil.WithFormat(MapErrorFormat{IncludeTypes: true}),
+			expected: `err.count=1 err.0.message=<nil>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}))
+
+			logger.Info("", "err", tt.value)
+
+			if got := strings.TrimSpace(buf.String()); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func ExampleMapError_Count() {
+	_, err := Map(func(a int) (int, error) {
+		if a%2 == 0 {
+			return a, nil
+		}
+		return 0, fmt.Errorf("%d: %w", a, ErrNotFound)
+	}, []int{1, 2, 3, 4, 5})
+
+	var mapError *MapError
+	if errors.As(err, &mapError) {
+		fmt.Println(mapError.Count(ErrNotFound))
+	}
+
+	fmt.Println(errors.Is(err, ErrNotFound))
+	// Output:
+	// 3
+	// true
+}
+
+func ExampleMapError_WithFormat() {
+	_, err := Map(func(a int) (int, error) {
+		if a%2 == 0 {
+			return a, nil
+		}
+		return 0, errors.New("testErr")
+	}, []int{1, 2, 3, 4, 5})
+
+	var mapError *MapError
+	if errors.As(err, &mapError) {
+		data, _ := json.Marshal(mapError.WithFormat(MapErrorFormat{Limit: 2}))
+		fmt.Println(string(data))
+	}
+	// Output:
+	// {"count":3,"errors":{"0":"testErr","2":"testErr"},"truncated":1}
+}
@@ -0,0 +1,222 @@
+package generics
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Option holds either a value (Some) or nothing (None).
+//
+// The zero value of Option is None. An Option marshals to JSON as its value, or
+// null when it is None, and can be used with database/sql as a nullable column.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Option holding v.
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+// None returns an Option holding no value.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionOf returns Some(v) if ok is true and None otherwise.
+// It is useful with functions returning a value and a boolean, such as map lookups.
+func OptionOf[T any](v T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+
+	return Some(v)
+}
+
+// IsSome returns true if the Option holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the Option holds no value.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// IsZero returns true if the Option holds no value.
+func (o Option[T]) IsZero() bool {
+	return !o.ok
+}
+
+// Get returns the value and true if the Option holds a value,
+// or the zero value and false otherwise.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// OrElse returns the value if the Option holds one, or def otherwise.
+func (o Option[T]) OrElse(def T) T {
+	if o.ok {
+		return o.value
+	}
+
+	return def
+}
+
+// OrElseFunc returns the value if the Option holds one, or the result of f otherwise.
+// f is only called if the Option is None.
+func (o Option[T]) OrElseFunc(f func() T) T {
+	if o.ok {
+		return o.value
+	}
+
+	return f()
+}
+
+// Map returns an Option holding the result of f applied to the value,
+// or None if the Option is None.
+// Use MapOption to change the type of the value.
+func (o Option[T]) Map(f func(T) T) Option[T] {
+	return MapOption(o, f)
+}
+
+// FlatMap returns the result of f applied to the value, or None if the Option is None.
+// Use FlatMapOption to change the type of the value.
+func (o Option[T]) FlatMap(f func(T) Option[T]) Option[T] {
+	return FlatMapOption(o, f)
+}
+
+// Filter returns the Option if it holds a value that satisfies the predicate,
+// or None otherwise.
+func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
+	if o.ok && predicate(o.value) {
+		return o
+	}
+
+	return None[T]()
+}
+
+// String returns a string representation of the Option.
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// MarshalJSON encodes the value, or null if the Option is None.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*o = Some(v)
+
+	return nil
+}
+
+// Scan implements sql.Scanner. A NULL column is scanned as None.
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+
+	*o = OptionOf(n.V, n.Valid)
+
+	return nil
+}
+
+// Value implements driver.Valuer. None is stored as NULL, and a value that
+// implements driver.Valuer itself is converted with its own Value method.
+func (o Option[T]) Value() (driver.Value, error) {
+	if !o.ok {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// MapOption returns an Option holding the result of f applied to the value,
+// or None if o is None.
+func MapOption[A any, B any](o Option[A], f func(A) B) Option[B] {
+	if !o.ok {
+		return None[B]()
+	}
+
+	return Some(f(o.value))
+}
+
+// FlatMapOption returns the result of f applied to the value, or None if o is None.
+func FlatMapOption[A any, B any](o Option[A], f func(A) Option[B]) Option[B] {
+	if !o.ok {
+		return None[B]()
+	}
+
+	return f(o.value)
+}
+
+// SelectOneOption returns the first element in a slice that satisfies the predicate,
+// or None if no such element is found.
+func SelectOneOption[T any](arr []T, f func(T) bool) Option[T] {
+	v, err := SelectOne(arr, f)
+
+	return OptionOf(v, err == nil)
+}
+
+// SelectLastOption returns the last element in a slice that satisfies the predicate,
+// or None if no such element is found.
+func SelectLastOption[T any](arr []T, f func(T) bool) Option[T] {
+	for i := len(arr) - 1; i >= 0; i-- {
+		if f(arr[i]) {
+			return Some(arr[i])
+		}
+	}
+
+	return None[T]()
+}
+
+// First returns the first element of a slice, or None if the slice is empty.
+func First[T any](arr []T) Option[T] {
+	if len(arr) == 0 {
+		return None[T]()
+	}
+
+	return Some(arr[0])
+}
+
+// Last returns the last element of a slice, or None if the slice is empty.
+func Last[T any](arr []T) Option[T] {
+	if len(arr) == 0 {
+		return None[T]()
+	}
+
+	return Some(arr[len(arr)-1])
+}
+
+// Lookup returns the value stored in a map under key, or None if the key is not present.
+func Lookup[K comparable, V any](m map[K]V, key K) Option[V] {
+	v, ok := m[key]
+
+	return OptionOf(v, ok)
+}
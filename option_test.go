@@ -0,0 +1,280 @@
+package generics
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := Some(42)
+
+		if !o.IsSome() || o.IsNone() || o.IsZero() {
+			t.Errorf("Expected Some, got %v", o)
+		}
+
+		v, ok := o.Get()
+		if !ok || v != 42 {
+			t.Errorf("Expected (42, true), got (%v, %v)", v, ok)
+		}
+
+		if o.OrElse(1) != 42 {
+			t.Errorf("Expected 42, got %v", o.OrElse(1))
+		}
+
+		if o.OrElseFunc(func() int { t.Errorf("OrElseFunc should not be called"); return 1 }) != 42 {
+			t.Errorf("Expected 42")
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[int]()
+
+		if o.IsSome() || !o.IsNone() || !o.IsZero() {
+			t.Errorf("Expected None, got %v", o)
+		}
+
+		v, ok := o.Get()
+		if ok || v != 0 {
+			t.Errorf("Expected (0, false), got (%v, %v)", v, ok)
+		}
+
+		if o.OrElse(1) != 1 {
+			t.Errorf("Expected 1, got %v", o.OrElse(1))
+		}
+
+		if o.OrElseFunc(func() int { return 2 }) != 2 {
+			t.Errorf("Expected 2, got %v", o.OrElseFunc(func() int { return 2 }))
+		}
+	})
+
+	t.Run("Zero value is None", func(t *testing.T) {
+		var o Option[string]
+
+		if !o.IsNone() {
+			t.Errorf("Expected None, got %v", o)
+		}
+	})
+}
+
+func TestOptionCombinators(t *testing.T) {
+	double := func(a int) int { return a * 2 }
+	even := func(a int) bool { return a%2 == 0 }
+	half := func(a int) Option[int] {
+		if a%2 != 0 {
+			return None[int]()
+		}
+		return Some(a / 2)
+	}
+
+	tests := []struct {
+		name     string
+		got      Option[int]
+		expected Option[int]
+	}{
+		{name: "Map Some", got: Some(2).Map(double), expected: Some(4)},
+		{name: "Map None", got: None[int]().Map(double), expected: None[int]()},
+		{name: "FlatMap Some to Some", got: Some(4).FlatMap(half), expected: Some(2)},
+		{name: "FlatMap Some to None", got: Some(3).FlatMap(half), expected: None[int]()},
+		{name: "FlatMap None", got: None[int]().FlatMap(half), expected: None[int]()},
+		{name: "Filter match", got: Some(2).Filter(even), expected: Some(2)},
+		{name: "Filter no match", got: Some(3).Filter(even), expected: None[int]()},
+		{name: "Filter None", got: None[int]().Filter(even), expected: None[int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.got)
+			}
+		})
+	}
+}
+
+func TestMapOption(t *testing.T) {
+	if got := MapOption(Some(42), strconv.Itoa); got != Some("42") {
+		t.Errorf("Expected Some(42), got %v", got)
+	}
+
+	if got := MapOption(None[int](), strconv.Itoa); got != None[string]() {
+		t.Errorf("Expected None, got %v", got)
+	}
+
+	parse := func(s string) Option[int] {
+		v, err := strconv.Atoi(s)
+		return OptionOf(v, err == nil)
+	}
+
+	if got := FlatMapOption(Some("12"), parse); got != Some(12) {
+		t.Errorf("Expected Some(12), got %v", got)
+	}
+
+	if got := FlatMapOption(Some("x"), parse); got != None[int]() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}
+
+func TestOptionLookups(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	even := func(a int) bool { return a%2 == 0 }
+	large := func(a int) bool { return a > 10 }
+
+	tests := []struct {
+		name     string
+		got      Option[int]
+		expected Option[int]
+	}{
+		{name: "SelectOneOption found", got: SelectOneOption(arr, even), expected: Some(2)},
+		{name: "SelectOneOption not found", got: SelectOneOption(arr, large), expected: None[int]()},
+		{name: "SelectLastOption found", got: SelectLastOption(arr, even), expected: Some(4)},
+		{name: "SelectLastOption not found", got: SelectLastOption(arr, large), expected: None[int]()},
+		{name: "First", got: First(arr), expected: Some(1)},
+		{name: "First empty", got: First([]int{}), expected: None[int]()},
+		{name: "Last", got: Last(arr), expected: Some(5)},
+		{name: "Last nil", got: Last[int](nil), expected: None[int]()},
+		{name: "Lookup found", got: Lookup(map[string]int{"a": 1}, "a"), expected: Some(1)},
+		{name: "Lookup zero value", got: Lookup(map[string]int{"a": 0}, "a"), expected: Some(0)},
+		{name: "Lookup not found", got: Lookup(map[string]int{"a": 1}, "b"), expected: None[int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.got)
+			}
+		})
+	}
+}
+
+func TestOptionJSON(t *testing.T) {
+	type record struct {
+		Name  Option[string] `json:"name"`
+		Count Option[int]    `json:"count"`
+	}
+
+	t.Run("Marshal", func(t *testing.T) {
+		data, err := json.Marshal(record{Name: Some("a")})
+		if err != nil {
+			t.Fatalf("Expected nil, got %v", err)
+		}
+
+		expected := `{"name":"a","count":null}`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			data     string
+			expected record
+		}{
+			{name: "Values", data: `{"name":"a","count":0}`, expected: record{Name: Some("a"), Count: Some(0)}},
+			{name: "Null", data: `{"name":null,"count":null}`, expected: record{}},
+			{name: "Missing", data: `{}`, expected: record{}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var r record
+				if err := json.Unmarshal([]byte(tt.data), &r); err != nil {
+					t.Fatalf("Expected nil, got %v", err)
+				}
+
+				if r != tt.expected {
+					t.Errorf("Expected %v, got %v", tt.expected, r)
+				}
+			})
+		}
+	})
+
+	t.Run("Unmarshal invalid", func(t *testing.T) {
+		var r record
+		if err := json.Unmarshal([]byte(`{"count":"a"}`), &r); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+}
+
+func TestOptionSQL(t *testing.T) {
+	t.Run("Scan", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			src      any
+			expected Option[int64]
+		}{
+			{name: "Null", src: nil, expected: None[int64]()},
+			{name: "Int", src: int64(42), expected: Some(int64(42))},
+			{name: "Bytes", src: []byte("42"), expected: Some(int64(42))},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				o := Some(int64(1))
+				if err := o.Scan(tt.src); err != nil {
+					t.Fatalf("Expected nil, got %v", err)
+				}
+
+				if o != tt.expected {
+					t.Errorf("Expected %v, got %v", tt.expected, o)
+				}
+			})
+		}
+	})
+
+	t.Run("Scan invalid", func(t *testing.T) {
+		var o Option[int64]
+		if err := o.Scan("a"); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+
+	t.Run("Value", func(t *testing.T) {
+		now := time.Now()
+
+		tests := []struct {
+			name     string
+			valuer   driver.Valuer
+			expected driver.Value
+		}{
+			{name: "None", valuer: None[int](), expected: nil},
+			{name: "Int", valuer: Some(42), expected: int64(42)},
+			{name: "String", valuer: Some("a"), expected: "a"},
+			{name: "Time", valuer: Some(now), expected: now},
+			{name: "Nested Option", valuer: Some(Some("a")), expected: "a"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				v, err := tt.valuer.Value()
+				if err != nil {
+					t.Fatalf("Expected nil, got %v", err)
+				}
+
+				if v != tt.expected {
+					t.Errorf("Expected %v, got %v", tt.expected, v)
+				}
+			})
+		}
+	})
+}
+
+func ExampleSelectOneOption() {
+	arr := []int{1, 2, 3, 4, 5}
+	selected := SelectOneOption(arr, func(a int) bool {
+		return a > 3
+	})
+
+	fmt.Println(selected)
+	fmt.Println(SelectOneOption(arr, func(a int) bool {
+		return a > 5
+	}).OrElse(-1))
+	// Output:
+	// Some(4)
+	// -1
+}
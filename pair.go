@@ -0,0 +1,182 @@
+package generics
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Pair is a simple tuple of two values of possibly different types.
+//
+// A Pair marshals to JSON as a two-element array. Convert it to a PairObject to
+// marshal it as an object instead.
+type Pair[A, B any] struct {
+	A A
+	B B
+}
+
+// NewPair returns a Pair holding a and b.
+func NewPair[A any, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{A: a, B: b}
+}
+
+// Swap returns a Pair with the values of p in the opposite order.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{A: p.B, B: p.A}
+}
+
+// Unpack returns the two values of the Pair.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.A, p.B
+}
+
+// String returns a string representation of the Pair.
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.A, p.B)
+}
+
+// Object returns the Pair as a PairObject, which marshals to JSON as an object.
+func (p Pair[A, B]) Object() PairObject[A, B] {
+	return PairObject[A, B](p)
+}
+
+// MarshalJSON encodes the Pair as a two-element array.
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]any{p.A, p.B})
+}
+
+// UnmarshalJSON decodes a Pair from a two-element array, or from an object with
+// "a" and "b" fields as produced by PairObject. Like the standard decoders,
+// it leaves the Pair unchanged when decoding null.
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		var obj PairObject[A, B]
+		if objErr := json.Unmarshal(data, &obj); objErr != nil {
+			return err
+		}
+
+		*p = Pair[A, B](obj)
+		return nil
+	}
+
+	if len(elements) != 2 {
+		return fmt.Errorf("pair must have 2 elements, got %d", len(elements))
+	}
+
+	var result Pair[A, B]
+	if err := json.Unmarshal(elements[0], &result.A); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(elements[1], &result.B); err != nil {
+		return err
+	}
+
+	*p = result
+
+	return nil
+}
+
+// PairObject is a Pair that marshals to JSON as an object with "a" and "b" fields
+// rather than as an array.
+type PairObject[A, B any] Pair[A, B]
+
+// Pair returns the PairObject as a Pair.
+func (p PairObject[A, B]) Pair() Pair[A, B] {
+	return Pair[A, B](p)
+}
+
+// MarshalJSON encodes the PairObject as an object with "a" and "b" fields.
+func (p PairObject[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		A A `json:"a"`
+		B B `json:"b"`
+	}{A: p.A, B: p.B})
+}
+
+// UnmarshalJSON decodes a PairObject from an object with "a" and "b" fields.
+func (p *PairObject[A, B]) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		A *json.RawMessage `json:"a"`
+		B *json.RawMessage `json:"b"`
+	}
+
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	if obj.A == nil || obj.B == nil {
+		return errors.New(`pair object must have "a" and "b" fields`)
+	}
+
+	var result PairObject[A, B]
+	if err := json.Unmarshal(*obj.A, &result.A); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(*obj.B, &result.B); err != nil {
+		return err
+	}
+
+	*p = result
+
+	return nil
+}
+
+// ComparePairs orders two Pairs by their first values, then by their second values.
+// It returns a negative number when x < y, a positive number when x > y and zero
+// when they are equal, so it can be used with slices.SortFunc.
+func ComparePairs[A cmp.Ordered, B cmp.Ordered](x, y Pair[A, B]) int {
+	if c := cmp.Compare(x.A, y.A); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.B, y.B)
+}
+
+// ComparePairsByA orders two Pairs by their first values only.
+func ComparePairsByA[A cmp.Ordered, B any](x, y Pair[A, B]) int {
+	return cmp.Compare(x.A, y.A)
+}
+
+// ComparePairsByB orders two Pairs by their second values only.
+func ComparePairsByB[A any, B cmp.Ordered](x, y Pair[A, B]) int {
+	return cmp.Compare(x.B, y.B)
+}
+
+// PairsFromMap returns the entries of a map as Pairs of key and value, sorted by key.
+func PairsFromMap[K cmp.Ordered, V any](m map[K]V) []Pair[K, V] {
+	result := make([]Pair[K, V], 0, len(m))
+
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		result = append(result, Pair[K, V]{A: k, B: m[k]})
+	}
+
+	return result
+}
+
+// MapFromPairs builds a map from Pairs of key and value.
+// If a key appears more than once, the last value wins.
+func MapFromPairs[K comparable, V any](pairs []Pair[K, V]) map[K]V {
+	result := make(map[K]V, len(pairs))
+
+	for _, p := range pairs {
+		result[p.A] = p.B
+	}
+
+	return result
+}
+
+// Triple is a simple tuple of three values of possibly different types.
+type Triple[A, B, C any] struct {
+	A A
+	B B
+	C C
+}
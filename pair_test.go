@@ -0,0 +1,183 @@
+package generics
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestPair(t *testing.T) {
+	p := NewPair("a", 1)
+
+	if p.A != "a" || p.B != 1 {
+		t.Errorf("Expected (a, 1), got %v", p)
+	}
+
+	if s := p.Swap(); s != NewPair(1, "a") {
+		t.Errorf("Expected (1, a), got %v", s)
+	}
+
+	a, b := p.Unpack()
+	if a != "a" || b != 1 {
+		t.Errorf("Expected a 1, got %v %v", a, b)
+	}
+
+	if s := p.String(); s != "(a, 1)" {
+		t.Errorf("Expected (a, 1), got %s", s)
+	}
+
+	if o := p.Object(); o.Pair() != p {
+		t.Errorf("Expected round trip through PairObject, got %v", o.Pair())
+	}
+}
+
+func TestPairJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "Array", value: NewPair("a", 1), expected: `["a",1]`},
+		{name: "Nested", value: NewPair(NewPair(1, 2), []string{"x"}), expected: `[[1,2],["x"]]`},
+		{name: "Object", value: NewPair("a", 1).Object(), expected: `{"a":"a","b":1}`},
+		{name: "Slice of pairs", value: []Pair[string, int]{{"a", 1}, {"b", 2}}, expected: `[["a",1],["b",2]]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Expected nil, got %v", err)
+			}
+
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+func TestPairUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         string
+		initial      Pair[string, int]
+		expected     Pair[string, int]
+		expectedFail bool
+	}{
+		{name: "Array", data: `["a",1]`, expected: NewPair("a", 1)},
+		{name: "Object", data: `{"a":"a","b":1}`, expected: NewPair("a", 1)},
+		{name: "Null", data: `null`, initial: NewPair("x", 9), expected: NewPair("x", 9)},
+		{name: "Too few elements", data: `["a"]`, expectedFail: true},
+		{name: "Too many elements", data: `["a",1,2]`, expectedFail: true},
+		{name: "Wrong element type", data: `["a","b"]`, expectedFail: true},
+		{name: "Missing field", data: `{"a":"a"}`, expectedFail: true},
+		{name: "Not a pair", data: `42`, expectedFail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.initial
+			err := json.Unmarshal([]byte(tt.data), &p)
+
+			if tt.expectedFail {
+				if err == nil {
+					t.Errorf("Expected error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %v", err)
+			}
+
+			if p != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, p)
+			}
+		})
+	}
+
+	t.Run("PairObject", func(t *testing.T) {
+		var o PairObject[string, int]
+		if err := json.Unmarshal([]byte(`{"b":2,"a":"z"}`), &o); err != nil {
+			t.Fatalf("Expected nil, got %v", err)
+		}
+
+		if o.Pair() != NewPair("z", 2) {
+			t.Errorf("Expected (z, 2), got %v", o.Pair())
+		}
+
+		if err := json.Unmarshal([]byte(`["z",2]`), &o); err == nil {
+			t.Errorf("Expected error for array, got nil")
+		}
+	})
+}
+
+func TestComparePairs(t *testing.T) {
+	pairs := []Pair[string, int]{{"b", 1}, {"a", 2}, {"b", 0}, {"a", 1}}
+
+	tests := []struct {
+		name     string
+		cmp      func(x, y Pair[string, int]) int
+		expected []Pair[string, int]
+	}{
+		{name: "ComparePairs", cmp: ComparePairs[string, int], expected: []Pair[string, int]{{"a", 1}, {"a", 2}, {"b", 0}, {"b", 1}}},
+		{name: "ComparePairsByA", cmp: ComparePairsByA[string, int], expected: []Pair[string, int]{{"a", 2}, {"a", 1}, {"b", 1}, {"b", 0}}},
+		{name: "ComparePairsByB", cmp: ComparePairsByB[string, int], expected: []Pair[string, int]{{"b", 0}, {"b", 1}, {"a", 1}, {"a", 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := slices.Clone(pairs)
+			slices.SortStableFunc(sorted, tt.cmp)
+
+			if !slices.Equal(sorted, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, sorted)
+			}
+		})
+	}
+}
+
+func TestPairsFromMap(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "b": 2}
+
+	pairs := PairsFromMap(m)
+	expected := []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}
+
+	if !slices.Equal(pairs, expected) {
+		t.Errorf("Expected %v, got %v", expected, pairs)
+	}
+
+	back := MapFromPairs(pairs)
+	if len(back) != len(m) {
+		t.Errorf("Expected %v, got %v", m, back)
+	}
+
+	for k, v := range m {
+		if back[k] != v {
+			t.Errorf("Expected %s==%d, got %d", k, v, back[k])
+		}
+	}
+
+	if got := MapFromPairs([]Pair[string, int]{{"a", 1}, {"a", 2}}); got["a"] != 2 {
+		t.Errorf("Expected last value to win, got %v", got)
+	}
+
+	if got := PairsFromMap[string, int](nil); len(got) != 0 {
+		t.Errorf("Expected no pairs, got %v", got)
+	}
+}
+
+func ExamplePairsFromMap() {
+	stock := map[string]int{"pears": 3, "apples": 5}
+
+	pairs := PairsFromMap(stock)
+	data, _ := json.Marshal(pairs)
+
+	fmt.Println(pairs)
+	fmt.Println(string(data))
+	// Output:
+	// [(apples, 5) (pears, 3)]
+	// [["apples",5],["pears",3]]
+}
@@ -0,0 +1,197 @@
+package generics
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// ErrorPolicy controls how concurrent functions such as ParallelApply react to errors.
+type ErrorPolicy int
+
+const (
+	// CollectAll processes every element and collects all errors into a MapError.
+	CollectAll ErrorPolicy = iota
+
+	// FailFast stops at the first error, cancelling the context passed to calls
+	// that are still running and starting no further calls.
+	FailFast
+)
+
+// PanicError is recorded in place of an error when a function run by one of the
+// Parallel functions panics.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+
+	// Stack is the stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+// Error returns a string representation of the PanicError.
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+// protect calls f, converting a panic into a *PanicError.
+func protect(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	return f()
+}
+
+// runParallel calls fn for each index in [0, n) using at most limit goroutines.
+// If limit is less than 1, every index is started in its own goroutine.
+// A panic in fn is recorded as a *PanicError for that index.
+//
+// It stops launching new work once ctx is done and records a *SkippedError for every
+// index that was never started. The returned slice holds the error for each index.
+//
+// With FailFast, the first error cancels the context passed to fn and its index is
+// returned as first. Otherwise, or if no error occurred, first is -1.
+func runParallel(ctx context.Context, limit int, n int, policy ErrorPolicy, fn func(context.Context, int) error) (errs []error, first int) {
+	errs = make([]error, n)
+	first = -1
+
+	if limit < 1 || limit > n {
+		limit = n
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	var once sync.Once
+
+	for i := 0; i < n; i++ {
+		if !acquire(ctx, sem) {
+			markNotStarted(ctx, errs, i)
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			errs[i] = protect(func() error { return fn(ctx, i) })
+
+			if errs[i] != nil && policy == FailFast {
+				once.Do(func() {
+					first = i
+					cancel()
+				})
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	return errs, first
+}
+
+// acquire takes a slot from sem, giving up if ctx is done first.
+func acquire(ctx context.Context, sem chan struct{}) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	select {
+	case sem <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// markNotStarted records a *SkippedError with the cause of ctx for every index from
+// start onwards.
+func markNotStarted(ctx context.Context, errs []error, start int) {
+	for i := start; i < len(errs); i++ {
+		errs[i] = &SkippedError{Cause: context.Cause(ctx)}
+	}
+}
+
+// collectErrors converts a slice of per-index errors into a MapError.
+// It returns nil if every entry is nil.
+func collectErrors(errs []error) error {
+	err := NewMapError()
+
+	for i, e := range errs {
+		if e != nil {
+			err.Add(i, e)
+		}
+	}
+
+	if err.HasError() {
+		return err
+	}
+
+	return nil
+}
+
+// ParallelMap applies f to each element of a slice concurrently, running at most
+// limit calls at once. If limit is less than 1, all elements are processed at once.
+//
+// Results are returned in the same order as arr. Errors are reported as a MapError
+// keyed by index, exactly like Map. Once ctx is done no further calls to f are
+// started, and every element that was not started is recorded in the MapError
+// as a *SkippedError. A panic in f is recorded as a *PanicError for that element.
+func ParallelMap[A any, B any](ctx context.Context, limit int, f func(context.Context, A) (B, error), arr []A) ([]B, error) {
+	results := make([]B, len(arr))
+
+	errs, _ := runParallel(ctx, limit, len(arr), CollectAll, func(ctx context.Context, i int) error {
+		b, err := f(ctx, arr[i])
+		results[i] = b
+		return err
+	})
+
+	return results, collectErrors(errs)
+}
+
+// ParallelSafeMap applies f to each element of a slice concurrently, running at most
+// limit calls at once. If limit is less than 1, all elements are processed at once.
+//
+// Results are returned in the same order as arr. The only errors reported are for
+// elements that were not started because ctx was done, and any panics in f, as a
+// MapError keyed by index.
+func ParallelSafeMap[A any, B any](ctx context.Context, limit int, f func(context.Context, A) B, arr []A) ([]B, error) {
+	results := make([]B, len(arr))
+
+	errs, _ := runParallel(ctx, limit, len(arr), CollectAll, func(ctx context.Context, i int) error {
+		results[i] = f(ctx, arr[i])
+		return nil
+	})
+
+	return results, collectErrors(errs)
+}
+
+// ParallelApply applies f to each element of a slice concurrently, running at most
+// limit calls at once. If limit is less than 1, all elements are processed at once.
+//
+// With CollectAll it behaves like Apply, returning a MapError containing every error
+// keyed by index, including a *SkippedError for elements not started because ctx was done.
+// With FailFast the first error cancels the context passed to calls still running,
+// no further calls are started, and the MapError contains only that first error.
+//
+// A panic in f does not crash the process; it is recorded as a *PanicError for that
+// element and treated like any other error.
+func ParallelApply[A any](ctx context.Context, limit int, policy ErrorPolicy, f func(context.Context, A) error, arr []A) error {
+	errs, first := runParallel(ctx, limit, len(arr), policy, func(ctx context.Context, i int) error {
+		return f(ctx, arr[i])
+	})
+
+	if first >= 0 {
+		err := NewMapError()
+		err.Add(first, errs[first])
+		return err
+	}
+
+	return collectErrors(errs)
+}
@@ -0,0 +1,316 @@
+package generics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMap(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		arr   []int
+	}{
+		{name: "limit 1", limit: 1, arr: []int{1, 2, 3, 4, 5}},
+		{name: "limit 2", limit: 2, arr: []int{1, 2, 3, 4, 5}},
+		{name: "limit larger than input", limit: 10, arr: []int{1, 2, 3, 4, 5}},
+		{name: "unbounded", limit: 0, arr: []int{1, 2, 3, 4, 5}},
+		{name: "empty array", limit: 2, arr: []int{}},
+		{name: "nil array", limit: 2, arr: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doubled, err := ParallelMap(context.Background(), tt.limit, func(_ context.Context, a int) (int, error) {
+				return a * 2, nil
+			}, tt.arr)
+
+			if err != nil {
+				t.Errorf("Expected nil, got %v", err)
+			}
+
+			if len(doubled) != len(tt.arr) {
+				t.Errorf("Expected length %d, got %d", len(tt.arr), len(doubled))
+			}
+
+			for i, v := range doubled {
+				if v != tt.arr[i]*2 {
+					t.Errorf("Expected arr[%d]==%d, got %d", i, tt.arr[i]*2, v)
+				}
+			}
+		})
+	}
+}
+
+func TestParallelMapWithError(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	doubled, err := ParallelMap(context.Background(), 2, func(_ context.Context, a int) (int, error) {
+		if a%2 == 0 {
+			return a * 2, nil
+		}
+		return 0, TestErrNotEven
+	}, arr)
+
+	var mapError *MapError
+	if !errors.As(err, &mapError) {
+		t.Fatalf("Expected *MapError, got %v", err)
+	}
+
+	if len(mapError.Errors) != 3 {
+		t.Errorf("Expected 3 errors, got %d", len(mapError.Errors))
+	}
+
+	for _, i := range []int{0, 2, 4} {
+		if mapError.Errors[i] != TestErrNotEven {
+			t.Errorf("Expected error at index %d, got %v", i, mapError.Errors[i])
+		}
+	}
+
+	for i, v := range doubled {
+		if _, exists := mapError.Errors[i]; !exists {
+			if v != arr[i]*2 {
+				t.Errorf("Expected %d, got %d", arr[i]*2, v)
+			}
+		}
+	}
+}
+
+func TestParallelMapLimit(t *testing.T) {
+	const limit = 3
+
+	var running, peak atomic.Int32
+	arr := make([]int, 20)
+
+	_, err := ParallelMap(context.Background(), limit, func(_ context.Context, a int) (int, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		return a, nil
+	}, arr)
+
+	if err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+
+	if p := peak.Load(); p > limit {
+		t.Errorf("Expected at most %d concurrent calls, got %d", limit, p)
+	}
+}
+
+func TestParallelMapCancelled(t *testing.T) {
+	t.Run("cancelled before start", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var calls atomic.Int32
+		_, err := ParallelMap(ctx, 2, func(_ context.Context, a int) (int, error) {
+			calls.Add(1)
+			return a, nil
+		}, []int{1, 2, 3})
+
+		if calls.Load() != 0 {
+			t.Errorf("Expected no calls, got %d", calls.Load())
+		}
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		for i := 0; i < 3; i++ {
+			if !errors.Is(mapError.Errors[i], context.Canceled) {
+				t.Errorf("Expected context.Canceled at index %d, got %v", i, mapError.Errors[i])
+			}
+		}
+
+		if len(mapError.Skipped()) != 3 {
+			t.Errorf("Expected 3 skipped indices, got %v", mapError.Skipped())
+		}
+	})
+
+	t.Run("cancelled during run", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, err := ParallelMap(ctx, 1, func(_ context.Context, a int) (int, error) {
+			if a == 2 {
+				cancel()
+			}
+			return a, nil
+		}, []int{1, 2, 3, 4, 5})
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		if _, exists := mapError.Errors[0]; exists {
+			t.Errorf("Expected no error at index 0, got %v", mapError.Errors[0])
+		}
+
+		if !errors.Is(mapError.Errors[4], context.Canceled) {
+			t.Errorf("Expected context.Canceled at index 4, got %v", mapError.Errors[4])
+		}
+	})
+}
+
+func TestParallelSafeMap(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	doubled, err := ParallelSafeMap(context.Background(), 2, func(_ context.Context, a int) int {
+		return a * 2
+	}, arr)
+
+	if err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+
+	for i, v := range doubled {
+		if v != arr[i]*2 {
+			t.Errorf("Expected %d, got %d", arr[i]*2, v)
+		}
+	}
+}
+
+func TestParallelApply(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        ErrorPolicy
+		arr           []int
+		expectedCount int
+	}{
+		{name: "collect all without errors", policy: CollectAll, arr: []int{2, 4, 6}, expectedCount: 0},
+		{name: "collect all with errors", policy: CollectAll, arr: []int{1, 2, 3, 4, 5}, expectedCount: 3},
+		{name: "fail fast without errors", policy: FailFast, arr: []int{2, 4, 6}, expectedCount: 0},
+		{name: "fail fast with errors", policy: FailFast, arr: []int{1, 2, 3, 4, 5}, expectedCount: 1},
+		{name: "nil array", policy: FailFast, arr: nil, expectedCount: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ParallelApply(context.Background(), 2, tt.policy, func(_ context.Context, a int) error {
+				if a%2 == 0 {
+					return nil
+				}
+				return TestErrNotEven
+			}, tt.arr)
+
+			if tt.expectedCount == 0 {
+				if err != nil {
+					t.Errorf("Expected nil, got %v", err)
+				}
+				return
+			}
+
+			var mapError *MapError
+			if !errors.As(err, &mapError) {
+				t.Fatalf("Expected *MapError, got %v", err)
+			}
+
+			if len(mapError.Errors) != tt.expectedCount {
+				t.Errorf("Expected %d errors, got %d", tt.expectedCount, len(mapError.Errors))
+			}
+		})
+	}
+}
+
+func TestParallelApplyFailFastCancelsSiblings(t *testing.T) {
+	var started atomic.Int32
+
+	err := ParallelApply(context.Background(), 2, FailFast, func(ctx context.Context, a int) error {
+		started.Add(1)
+		if a == 0 {
+			return TestErrNotEven
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+			return errors.New("sibling was not cancelled")
+		}
+	}, []int{0, 1, 2, 3, 4, 5})
+
+	var mapError *MapError
+	if !errors.As(err, &mapError) {
+		t.Fatalf("Expected *MapError, got %v", err)
+	}
+
+	if mapError.Errors[0] != TestErrNotEven {
+		t.Errorf("Expected first error at index 0, got %v", mapError.Errors)
+	}
+
+	if n := started.Load(); n > 2 {
+		t.Errorf("Expected at most 2 calls to start, got %d", n)
+	}
+}
+
+func TestParallelApplyPanic(t *testing.T) {
+	for _, policy := range []ErrorPolicy{CollectAll, FailFast} {
+		err := ParallelApply(context.Background(), 1, policy, func(_ context.Context, a int) error {
+			if a == 2 {
+				panic("boom")
+			}
+			return nil
+		}, []int{1, 2, 3})
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		var panicError *PanicError
+		if !errors.As(mapError.Errors[1], &panicError) {
+			t.Fatalf("Expected *PanicError at index 1, got %v", mapError.Errors[1])
+		}
+
+		if panicError.Value != "boom" {
+			t.Errorf("Expected panic value boom, got %v", panicError.Value)
+		}
+
+		if len(panicError.Stack) == 0 {
+			t.Errorf("Expected stack trace, got none")
+		}
+	}
+}
+
+func ExampleParallelMap() {
+	arr := []int{1, 2, 3, 4, 5}
+	doubled, err := ParallelMap(context.Background(), 2, func(_ context.Context, a int) (int, error) {
+		return a * 2, nil
+	}, arr)
+
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(doubled)
+	// Output: [2 4 6 8 10]
+}
+
+func ExampleParallelApply() {
+	arr := []int{1, 2, 3, 4, 5}
+	err := ParallelApply(context.Background(), 2, CollectAll, func(_ context.Context, a int) error {
+		if a%2 == 0 {
+			return nil
+		}
+		return errors.New("testErr")
+	}, arr)
+
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Output: 3 errors: index 0: testErr; index 2: testErr; index 4: testErr
+}
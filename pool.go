@@ -0,0 +1,288 @@
+package generics
+
+import (
+	"context"
+	"sync"
+)
+
+// Pool runs jobs of type In through a handler using a fixed number of worker
+// goroutines, producing results of type Out.
+//
+// Jobs are submitted with Submit or SubmitAll, which return a Future for each job.
+// The handler receives the context passed to Submit, which is also cancelled if the
+// Pool is shut down before the job finishes. A panic in the handler does not crash
+// the process; it is recorded as a *PanicError in the job's Result.
+//
+// A Pool must be created with NewPool and should be closed with Close or Shutdown
+// when no longer needed, to stop its workers.
+type Pool[In any, Out any] struct {
+	handler func(context.Context, In) (Out, error)
+	jobs    chan poolJob[In, Out]
+
+	// quit is closed when the Pool starts shutting down, to wake blocked submitters.
+	quit      chan struct{}
+	closeOnce sync.Once
+
+	// ctx is cancelled to abort running jobs when Shutdown gives up waiting.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.RWMutex
+	closed  bool
+	workers sync.WaitGroup
+}
+
+type poolJob[In any, Out any] struct {
+	ctx    context.Context
+	in     In
+	future *Future[Out]
+}
+
+// NewPool creates a Pool that runs handler on at most workers jobs at once.
+// If workers is less than 1, a single worker is used.
+func NewPool[In any, Out any](workers int, handler func(context.Context, In) (Out, error)) *Pool[In, Out] {
+	workers = max(workers, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p := &Pool[In, Out]{
+		handler: handler,
+		jobs:    make(chan poolJob[In, Out]),
+		quit:    make(chan struct{}),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	p.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+
+	return p
+}
+
+func (p *Pool[In, Out]) work() {
+	defer p.workers.Done()
+
+	for job := range p.jobs {
+		job.future.complete(p.run(job))
+	}
+}
+
+// run calls the handler for a job, unless its context is already done.
+func (p *Pool[In, Out]) run(job poolJob[In, Out]) Result[Out] {
+	ctx, cancel := context.WithCancel(job.ctx)
+	defer cancel()
+
+	stop := context.AfterFunc(p.ctx, cancel)
+	defer stop()
+
+	if ctx.Err() != nil {
+		return Err[Out](&SkippedError{Cause: context.Cause(ctx)})
+	}
+
+	var out Out
+	err := protect(func() error {
+		var err error
+		out, err = p.handler(ctx, job.in)
+		return err
+	})
+
+	return ResultOf(out, err)
+}
+
+// Submit queues a job for in and returns a Future for its result.
+//
+// Submit blocks until a worker accepts the job. It returns ErrPoolClosed if the Pool
+// is closed, or the error of ctx if ctx is done before the job is accepted.
+// If ctx is done after the job is accepted but before it starts, the job's Result
+// holds a *SkippedError.
+func (p *Pool[In, Out]) Submit(ctx context.Context, in In) (*Future[Out], error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return nil, ErrPoolClosed
+	}
+
+	job := poolJob[In, Out]{ctx: ctx, in: in, future: newFuture[Out]()}
+
+	select {
+	case p.jobs <- job:
+		return job.future, nil
+	case <-p.quit:
+		return nil, ErrPoolClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// SubmitAll submits a job for each element of ins, in order, and returns their
+// Futures in the same order.
+//
+// Like Apply, it attempts every submission and reports those that failed as a
+// MapError keyed by index, leaving a nil Future at each such index.
+func (p *Pool[In, Out]) SubmitAll(ctx context.Context, ins []In) ([]*Future[Out], error) {
+	futures := make([]*Future[Out], len(ins))
+	err := NewMapError()
+
+	for i, in := range ins {
+		f, e := p.Submit(ctx, in)
+		if e != nil {
+			err.Add(i, e)
+		}
+		futures[i] = f
+	}
+
+	if err.HasError() {
+		return futures, err
+	}
+
+	return futures, nil
+}
+
+// Map submits a job for each element of ins and waits for them all, returning their
+// results in the same order. Like Map, errors are reported as a MapError keyed by
+// index, whether the job could not be submitted, failed, or was not finished when
+// ctx was done.
+func (p *Pool[In, Out]) Map(ctx context.Context, ins []In) ([]Out, error) {
+	results := make([]Out, len(ins))
+	futures := make([]*Future[Out], len(ins))
+	err := NewMapError()
+
+	for i, in := range ins {
+		f, e := p.Submit(ctx, in)
+		if e != nil {
+			err.Add(i, e)
+		}
+		futures[i] = f
+	}
+
+	for i, f := range futures {
+		if f == nil {
+			continue
+		}
+
+		v, e := f.Wait(ctx)
+		if e != nil {
+			err.Add(i, e)
+		}
+		results[i] = v
+	}
+
+	if err.HasError() {
+		return results, err
+	}
+
+	return results, nil
+}
+
+// Close stops the Pool from accepting new jobs and waits for the jobs already
+// accepted to finish. It is safe to call more than once.
+func (p *Pool[In, Out]) Close() {
+	p.stop()
+	p.workers.Wait()
+	p.cancel()
+}
+
+// Shutdown stops the Pool from accepting new jobs and waits for the jobs already
+// accepted to finish, like Close.
+//
+// If ctx is done first, Shutdown cancels the contexts of the running jobs and returns
+// the error of ctx without waiting for them to return.
+func (p *Pool[In, Out]) Shutdown(ctx context.Context) error {
+	p.stop()
+
+	drained := make(chan struct{})
+	go func() {
+		p.workers.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		p.cancel()
+		return nil
+	case <-ctx.Done():
+		p.cancel()
+		return ctx.Err()
+	}
+}
+
+// stop marks the Pool as closed and closes the job queue once no Submit is in progress.
+func (p *Pool[In, Out]) stop() {
+	p.closeOnce.Do(func() {
+		close(p.quit)
+
+		p.mu.Lock()
+		p.closed = true
+		close(p.jobs)
+		p.mu.Unlock()
+	})
+}
+
+// Future holds the Result of a job submitted to a Pool, which becomes available once
+// the job has finished.
+type Future[T any] struct {
+	done   chan struct{}
+	result Result[T]
+}
+
+func newFuture[T any]() *Future[T] {
+	return &Future[T]{done: make(chan struct{})}
+}
+
+func (f *Future[T]) complete(r Result[T]) {
+	f.result = r
+	close(f.done)
+}
+
+// Done returns a channel that is closed when the job has finished.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Result waits for the job to finish and returns its Result.
+func (f *Future[T]) Result() Result[T] {
+	<-f.done
+	return f.result
+}
+
+// Wait waits for the job to finish and returns its value and error, or returns the
+// error of ctx if ctx is done first.
+func (f *Future[T]) Wait(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.result.Unwrap()
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// AwaitAll waits for each of the Futures in turn and returns their values in the
+// same order. Like Map, errors are reported as a MapError keyed by index, including
+// the error of ctx for every Future not finished when ctx was done.
+// Nil Futures, as left by SubmitAll for failed submissions, are ignored.
+func AwaitAll[T any](ctx context.Context, futures []*Future[T]) ([]T, error) {
+	values := make([]T, len(futures))
+	err := NewMapError()
+
+	for i, f := range futures {
+		if f == nil {
+			continue
+		}
+
+		v, e := f.Wait(ctx)
+		if e != nil {
+			err.Add(i, e)
+		}
+		values[i] = v
+	}
+
+	if err.HasError() {
+		return values, err
+	}
+
+	return values, nil
+}
@@ -0,0 +1,276 @@
+package generics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPoolSubmit(t *testing.T) {
+	p := NewPool(2, func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	defer p.Close()
+
+	f, err := p.Submit(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+
+	<-f.Done()
+
+	if v, err := f.Result().Unwrap(); v != 42 || err != nil {
+		t.Errorf("Expected 42, got %v and %v", v, err)
+	}
+
+	f, _ = p.Submit(context.Background(), "x")
+	if _, err := f.Wait(context.Background()); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected strconv.ErrSyntax, got %v", err)
+	}
+}
+
+func TestPoolLimitsWorkers(t *testing.T) {
+	var running, peak atomic.Int32
+
+	p := NewPool(3, func(_ context.Context, n int) (int, error) {
+		cur := running.Add(1)
+		for {
+			old := peak.Load()
+			if cur <= old || peak.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+
+		return n * 2, nil
+	})
+	defer p.Close()
+
+	results, err := p.Map(context.Background(), []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	if err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+
+	if !slices.Equal(results, []int{2, 4, 6, 8, 10, 12, 14, 16, 18, 20}) {
+		t.Errorf("Expected doubled values in order, got %v", results)
+	}
+
+	if peak.Load() > 3 {
+		t.Errorf("Expected at most 3 concurrent jobs, got %d", peak.Load())
+	}
+}
+
+func TestPoolErrors(t *testing.T) {
+	p := NewPool(2, func(_ context.Context, n int) (int, error) {
+		switch n {
+		case 2:
+			return 0, TestErrNotEven
+		case 3:
+			panic("boom")
+		}
+		return n, nil
+	})
+	defer p.Close()
+
+	results, err := p.Map(context.Background(), []int{1, 2, 3, 4})
+
+	var mapError *MapError
+	if !errors.As(err, &mapError) {
+		t.Fatalf("Expected *MapError, got %v", err)
+	}
+
+	if !slices.Equal(mapError.Indices(), []int{1, 2}) {
+		t.Errorf("Expected errors at [1 2], got %v", mapError.Indices())
+	}
+
+	var panicErr *PanicError
+	if !errors.As(mapError.Errors[2], &panicErr) || panicErr.Value != "boom" {
+		t.Errorf("Expected *PanicError at index 2, got %v", mapError.Errors[2])
+	}
+
+	if results[0] != 1 || results[3] != 4 {
+		t.Errorf("Expected successful results to be kept, got %v", results)
+	}
+}
+
+func TestPoolClose(t *testing.T) {
+	release := make(chan struct{})
+	var finished atomic.Int32
+
+	p := NewPool(2, func(_ context.Context, n int) (int, error) {
+		<-release
+		finished.Add(1)
+		return n, nil
+	})
+
+	futures, err := p.SubmitAll(context.Background(), []int{1, 2})
+	if err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		p.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+		t.Fatalf("Expected Close to wait for running jobs")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+	<-closed
+
+	if finished.Load() != 2 {
+		t.Errorf("Expected 2 jobs to finish, got %d", finished.Load())
+	}
+
+	values, err := AwaitAll(context.Background(), futures)
+	if err != nil || !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v and %v", values, err)
+	}
+
+	if _, err := p.Submit(context.Background(), 3); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Expected ErrPoolClosed, got %v", err)
+	}
+
+	futures, err = p.SubmitAll(context.Background(), []int{4, 5})
+
+	var mapError *MapError
+	if !errors.As(err, &mapError) || mapError.Count(ErrPoolClosed) != 2 {
+		t.Errorf("Expected 2 ErrPoolClosed errors, got %v", err)
+	}
+
+	if futures[0] != nil || futures[1] != nil {
+		t.Errorf("Expected nil futures, got %v", futures)
+	}
+
+	p.Close()
+}
+
+func TestPoolShutdown(t *testing.T) {
+	t.Run("drains", func(t *testing.T) {
+		p := NewPool(1, func(_ context.Context, n int) (int, error) {
+			time.Sleep(5 * time.Millisecond)
+			return n, nil
+		})
+
+		f, _ := p.Submit(context.Background(), 1)
+
+		if err := p.Shutdown(context.Background()); err != nil {
+			t.Errorf("Expected nil error, got %v", err)
+		}
+
+		if v, err := f.Result().Unwrap(); v != 1 || err != nil {
+			t.Errorf("Expected 1, got %v and %v", v, err)
+		}
+	})
+
+	t.Run("cancels running jobs on timeout", func(t *testing.T) {
+		p := NewPool(1, func(ctx context.Context, n int) (int, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		})
+
+		f, _ := p.Submit(context.Background(), 1)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		if err := p.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+
+		if err := f.Result().Err(); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected the job to be cancelled, got %v", err)
+		}
+	})
+}
+
+func TestPoolSubmitCancelled(t *testing.T) {
+	release := make(chan struct{})
+
+	p := NewPool(1, func(_ context.Context, n int) (int, error) {
+		<-release
+		return n, nil
+	})
+	defer p.Close()
+	defer close(release)
+
+	if _, err := p.Submit(context.Background(), 1); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := p.Submit(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded while all workers are busy, got %v", err)
+	}
+}
+
+func TestFutureWait(t *testing.T) {
+	release := make(chan struct{})
+
+	p := NewPool(1, func(_ context.Context, n int) (int, error) {
+		<-release
+		return n, nil
+	})
+	defer p.Close()
+
+	f, _ := p.Submit(context.Background(), 7)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := f.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	values, err := AwaitAll(ctx, []*Future[int]{f, nil})
+
+	var mapError *MapError
+	if !errors.As(err, &mapError) || !slices.Equal(mapError.Indices(), []int{0}) {
+		t.Errorf("Expected an error at index 0 only, got %v", err)
+	}
+
+	if len(values) != 2 {
+		t.Errorf("Expected 2 values, got %v", values)
+	}
+
+	close(release)
+
+	if v, err := f.Wait(context.Background()); v != 7 || err != nil {
+		t.Errorf("Expected 7, got %v and %v", v, err)
+	}
+}
+
+func ExamplePool() {
+	p := NewPool(4, func(_ context.Context, n int) (string, error) {
+		if n < 0 {
+			return "", errors.New("negative")
+		}
+		return strconv.Itoa(n * n), nil
+	})
+	defer p.Close()
+
+	f, _ := p.Submit(context.Background(), 3)
+	fmt.Println(f.Result())
+
+	results, err := p.Map(context.Background(), []int{1, -2, 3})
+	fmt.Printf("%q\n", results)
+	fmt.Println(err)
+	// Output:
+	// Ok(9)
+	// ["1" "" "9"]
+	// 1 error: index 1: negative
+}
@@ -0,0 +1,181 @@
+package generics
+
+import "fmt"
+
+// Result holds either a value (Ok) or an error (Err).
+//
+// The zero value of Result is Ok holding the zero value of T.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a Result holding err.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// ResultOf returns Err(err) if err is not nil and Ok(v) otherwise.
+// It is useful with functions returning a value and an error.
+func ResultOf[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+
+	return Ok(v)
+}
+
+// IsOk returns true if the Result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Err returns the error held by the Result, or nil if it holds a value.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value and error held by the Result.
+// If the Result holds an error, the value is the zero value of T.
+func (r Result[T]) Unwrap() (T, error) {
+	if r.err != nil {
+		var zero T
+		return zero, r.err
+	}
+
+	return r.value, nil
+}
+
+// OrElse returns the value if the Result holds one, or def otherwise.
+func (r Result[T]) OrElse(def T) T {
+	if r.err != nil {
+		return def
+	}
+
+	return r.value
+}
+
+// OrElseFunc returns the value if the Result holds one, or the result of f applied
+// to the error otherwise. f is only called if the Result holds an error.
+func (r Result[T]) OrElseFunc(f func(error) T) T {
+	if r.err != nil {
+		return f(r.err)
+	}
+
+	return r.value
+}
+
+// Option returns Some holding the value if the Result holds one, or None otherwise.
+func (r Result[T]) Option() Option[T] {
+	return OptionOf(r.value, r.err == nil)
+}
+
+// Map returns a Result holding the result of f applied to the value,
+// or the same error if the Result holds an error.
+// Use MapResult to change the type of the value.
+func (r Result[T]) Map(f func(T) T) Result[T] {
+	return MapResult(r, f)
+}
+
+// FlatMap returns the result of f applied to the value,
+// or the same error if the Result holds an error.
+// Use FlatMapResult to change the type of the value.
+func (r Result[T]) FlatMap(f func(T) Result[T]) Result[T] {
+	return FlatMapResult(r, f)
+}
+
+// String returns a string representation of the Result.
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+// MapResult returns a Result holding the result of f applied to the value,
+// or the same error if r holds an error.
+func MapResult[A any, B any](r Result[A], f func(A) B) Result[B] {
+	if r.err != nil {
+		return Err[B](r.err)
+	}
+
+	return Ok(f(r.value))
+}
+
+// FlatMapResult returns the result of f applied to the value,
+// or the same error if r holds an error.
+func FlatMapResult[A any, B any](r Result[A], f func(A) Result[B]) Result[B] {
+	if r.err != nil {
+		return Err[B](r.err)
+	}
+
+	return f(r.value)
+}
+
+// MapResults applies a function that can return an error to each element of a slice.
+// Unlike Map, it returns one Result per element, so each output slot carries its
+// own value or error.
+func MapResults[A any, B any](f func(A) (B, error), arr []A) []Result[B] {
+	results := make([]Result[B], len(arr))
+
+	for i, a := range arr {
+		results[i] = ResultOf(f(a))
+	}
+
+	return results
+}
+
+// CollectResults converts a slice of Results into the form returned by Map:
+// a slice holding every value, with zero values for errors, and a MapError
+// keyed by index if any Result holds an error.
+func CollectResults[T any](results []Result[T]) ([]T, error) {
+	values := make([]T, len(results))
+	err := NewMapError()
+
+	for i, r := range results {
+		v, e := r.Unwrap()
+		if e != nil {
+			err.Add(i, e)
+		}
+		values[i] = v
+	}
+
+	if err.HasError() {
+		return values, err
+	}
+
+	return values, nil
+}
+
+// SplitResults separates a slice of Results into the values of those that succeeded,
+// in their original order, and a MapError holding the errors keyed by their index
+// in results. The error is nil if every Result holds a value.
+func SplitResults[T any](results []Result[T]) ([]T, error) {
+	values := make([]T, 0, len(results))
+	err := NewMapError()
+
+	for i, r := range results {
+		if r.err != nil {
+			err.Add(i, r.err)
+		} else {
+			values = append(values, r.value)
+		}
+	}
+
+	if err.HasError() {
+		return values, err
+	}
+
+	return values, nil
+}
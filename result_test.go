@@ -0,0 +1,201 @@
+package generics
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		r := Ok(42)
+
+		if !r.IsOk() || r.IsErr() || r.Err() != nil {
+			t.Errorf("Expected Ok, got %v", r)
+		}
+
+		v, err := r.Unwrap()
+		if err != nil || v != 42 {
+			t.Errorf("Expected (42, nil), got (%v, %v)", v, err)
+		}
+
+		if r.OrElse(1) != 42 {
+			t.Errorf("Expected 42, got %v", r.OrElse(1))
+		}
+
+		if r.Option() != Some(42) {
+			t.Errorf("Expected Some(42), got %v", r.Option())
+		}
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		r := Err[int](TestErrNotEven)
+
+		if r.IsOk() || !r.IsErr() || r.Err() != TestErrNotEven {
+			t.Errorf("Expected Err, got %v", r)
+		}
+
+		v, err := r.Unwrap()
+		if err != TestErrNotEven || v != 0 {
+			t.Errorf("Expected (0, not even), got (%v, %v)", v, err)
+		}
+
+		if r.OrElse(1) != 1 {
+			t.Errorf("Expected 1, got %v", r.OrElse(1))
+		}
+
+		if got := r.OrElseFunc(func(err error) int { return len(err.Error()) }); got != 8 {
+			t.Errorf("Expected 8, got %v", got)
+		}
+
+		if r.Option() != None[int]() {
+			t.Errorf("Expected None, got %v", r.Option())
+		}
+	})
+
+	t.Run("ResultOf", func(t *testing.T) {
+		if r := ResultOf(strconv.Atoi("12")); r != Ok(12) {
+			t.Errorf("Expected Ok(12), got %v", r)
+		}
+
+		if r := ResultOf(strconv.Atoi("x")); r.IsOk() {
+			t.Errorf("Expected Err, got %v", r)
+		}
+
+		if r := ResultOf(4, TestErrNotEven); r.IsOk() || r.OrElse(0) != 0 {
+			t.Errorf("Expected Err discarding value, got %v", r)
+		}
+	})
+}
+
+func TestResultCombinators(t *testing.T) {
+	double := func(a int) int { return a * 2 }
+	half := func(a int) Result[int] {
+		if a%2 != 0 {
+			return Err[int](TestErrNotEven)
+		}
+		return Ok(a / 2)
+	}
+
+	tests := []struct {
+		name     string
+		got      Result[int]
+		expected Result[int]
+	}{
+		{name: "Map Ok", got: Ok(2).Map(double), expected: Ok(4)},
+		{name: "Map Err", got: Err[int](TestErrOther).Map(double), expected: Err[int](TestErrOther)},
+		{name: "FlatMap Ok to Ok", got: Ok(4).FlatMap(half), expected: Ok(2)},
+		{name: "FlatMap Ok to Err", got: Ok(3).FlatMap(half), expected: Err[int](TestErrNotEven)},
+		{name: "FlatMap Err", got: Err[int](TestErrOther).FlatMap(half), expected: Err[int](TestErrOther)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.got)
+			}
+		})
+	}
+
+	if got := MapResult(Ok(42), strconv.Itoa); got != Ok("42") {
+		t.Errorf("Expected Ok(42), got %v", got)
+	}
+
+	if got := FlatMapResult(Ok("x"), func(s string) Result[int] { return ResultOf(strconv.Atoi(s)) }); got.IsOk() {
+		t.Errorf("Expected Err, got %v", got)
+	}
+}
+
+func TestMapResults(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	results := MapResults(func(a int) (int, error) {
+		if a%2 == 0 {
+			return a * 2, nil
+		}
+		return 0, TestErrNotEven
+	}, arr)
+
+	if len(results) != len(arr) {
+		t.Fatalf("Expected %d results, got %d", len(arr), len(results))
+	}
+
+	for i, r := range results {
+		if arr[i]%2 == 0 {
+			if r != Ok(arr[i]*2) {
+				t.Errorf("Expected results[%d]==Ok(%d), got %v", i, arr[i]*2, r)
+			}
+		} else if r.Err() != TestErrNotEven {
+			t.Errorf("Expected results[%d] to hold an error, got %v", i, r)
+		}
+	}
+
+	t.Run("CollectResults", func(t *testing.T) {
+		values, err := CollectResults(results)
+
+		expected := []int{0, 4, 0, 8, 0}
+		for i, v := range values {
+			if v != expected[i] {
+				t.Errorf("Expected values[%d]==%d, got %d", i, expected[i], v)
+			}
+		}
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		for _, i := range []int{0, 2, 4} {
+			if mapError.Errors[i] != TestErrNotEven {
+				t.Errorf("Expected error at index %d, got %v", i, mapError.Errors[i])
+			}
+		}
+	})
+
+	t.Run("SplitResults", func(t *testing.T) {
+		values, err := SplitResults(results)
+
+		if len(values) != 2 || values[0] != 4 || values[1] != 8 {
+			t.Errorf("Expected [4 8], got %v", values)
+		}
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		if len(mapError.Errors) != 3 {
+			t.Errorf("Expected 3 errors, got %d", len(mapError.Errors))
+		}
+	})
+
+	t.Run("No errors", func(t *testing.T) {
+		results := []Result[int]{Ok(1), Ok(2)}
+
+		if _, err := CollectResults(results); err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+
+		if _, err := SplitResults(results); err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+	})
+}
+
+func ExampleMapResults() {
+	results := MapResults(strconv.Atoi, []string{"1", "x", "3"})
+
+	for _, r := range results {
+		fmt.Println(r.OrElse(-1))
+	}
+
+	values, err := SplitResults(results)
+	fmt.Println(values)
+	fmt.Println(err != nil)
+	// Output:
+	// 1
+	// -1
+	// 3
+	// [1 3]
+	// true
+}
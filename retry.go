@@ -0,0 +1,190 @@
+package generics
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff returns the delay to wait before a retry. The attempt that failed is
+// numbered from 1, so Backoff(1) is the delay before the second attempt.
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff waits the same delay d before every retry.
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff waits base before the first retry and doubles the delay before
+// each later one, up to limit. If limit is zero or less, the delay is not limited.
+func ExponentialBackoff(base time.Duration, limit time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt && d < math.MaxInt64/2; i++ {
+			if limit > 0 && d >= limit {
+				break
+			}
+			d *= 2
+		}
+
+		if limit > 0 && d > limit {
+			return limit
+		}
+
+		return d
+	}
+}
+
+// JitterBackoff waits a random delay between zero and the delay returned by b,
+// so that many callers retrying at once spread out rather than retrying in step.
+func JitterBackoff(b Backoff) Backoff {
+	return func(attempt int) time.Duration {
+		d := b(attempt)
+		if d <= 0 {
+			return 0
+		}
+
+		if d == math.MaxInt64 {
+			// d + 1 would overflow.
+			return rand.N(d)
+		}
+		return rand.N(d + 1)
+	}
+}
+
+// Clock provides the timers used to wait between retries. Tests can provide a
+// Clock whose channels fire immediately to avoid real delays.
+type Clock interface {
+	// After returns a channel that receives the current time once d has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the Clock backed by the time package.
+type realClock struct{}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// RetryPolicy controls how RetryMap and RetryApply retry a failing call for an element.
+// The zero value makes a single attempt, with no retries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of calls for each element, including the
+	// first. Values less than 1 mean a single attempt.
+	MaxAttempts int
+
+	// Backoff returns the delay before each retry. If nil, retries are immediate.
+	Backoff Backoff
+
+	// Retryable reports whether an error may succeed if retried. If nil, every
+	// error is retried.
+	Retryable func(error) bool
+
+	// Clock provides the timers for the delays. If nil, real time is used.
+	Clock Clock
+}
+
+// RetryOutcome records how an element fared under a RetryPolicy.
+type RetryOutcome struct {
+	// Attempts is the number of calls made for the element. It is zero if the
+	// element was skipped because the context was done.
+	Attempts int
+
+	// Err is the error of the last call, or nil if it succeeded.
+	Err error
+}
+
+// wait waits for the delay before retrying after the given attempt. It returns false
+// if ctx is done first.
+func (p RetryPolicy) wait(ctx context.Context, attempt int) bool {
+	var d time.Duration
+	if p.Backoff != nil {
+		d = p.Backoff(attempt)
+	}
+
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+
+	clock := p.Clock
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	select {
+	case <-clock.After(d):
+		return ctx.Err() == nil
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// retry calls f until it succeeds, returns an error that is not retryable, reaches
+// the maximum number of attempts or ctx is done while waiting to retry.
+func retry[B any](ctx context.Context, p RetryPolicy, f func(context.Context) (B, error)) (B, RetryOutcome) {
+	maxAttempts := max(p.MaxAttempts, 1)
+
+	for attempt := 1; ; attempt++ {
+		b, err := f(ctx)
+
+		done := err == nil || attempt >= maxAttempts || (p.Retryable != nil && !p.Retryable(err))
+		if done || !p.wait(ctx, attempt) {
+			return b, RetryOutcome{Attempts: attempt, Err: err}
+		}
+	}
+}
+
+// RetryMap is like MapCtx, but retries the call for each element according to policy.
+//
+// Elements are processed in order, each retried until it succeeds or the policy gives
+// up, before moving on to the next. The outcomes hold the number of attempts and the
+// final error for each element, in the same order as arr. The final errors are also
+// returned as a MapError keyed by index, and the result for an element is that of its
+// last attempt.
+//
+// If ctx is done while waiting to retry, the element keeps the error of its last
+// attempt. Elements not reached because ctx was done are recorded as a *SkippedError
+// with no attempts.
+func RetryMap[A any, B any](ctx context.Context, policy RetryPolicy, f func(context.Context, A) (B, error), arr []A) ([]B, []RetryOutcome, error) {
+	results := make([]B, len(arr))
+	outcomes := make([]RetryOutcome, len(arr))
+
+	err := NewMapError()
+
+	for i, a := range arr {
+		if ctx.Err() != nil {
+			skipRemaining(ctx, err, i, len(arr))
+			for j := i; j < len(arr); j++ {
+				outcomes[j].Err = err.Errors[j]
+			}
+			break
+		}
+
+		results[i], outcomes[i] = retry(ctx, policy, func(ctx context.Context) (B, error) {
+			return f(ctx, a)
+		})
+
+		if outcomes[i].Err != nil {
+			err.Add(i, outcomes[i].Err)
+		}
+	}
+
+	if err.HasError() {
+		return results, outcomes, err
+	}
+
+	return results, outcomes, nil
+}
+
+// RetryApply is like ApplyCtx, but retries the call for each element according to
+// policy. It reports the outcomes and errors exactly like RetryMap.
+func RetryApply[A any](ctx context.Context, policy RetryPolicy, f func(context.Context, A) error, arr []A) ([]RetryOutcome, error) {
+	_, outcomes, err := RetryMap(ctx, policy, func(ctx context.Context, a A) (struct{}, error) {
+		return struct{}{}, f(ctx, a)
+	}, arr)
+
+	return outcomes, err
+}
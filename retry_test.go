@@ -0,0 +1,294 @@
+package generics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+var (
+	TestErrTransient = errors.New("transient")
+	TestErrPermanent = errors.New("permanent")
+)
+
+// fakeClock is a Clock whose timers fire immediately, recording each delay.
+type fakeClock struct {
+	mu     sync.Mutex
+	delays []time.Duration
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.delays = append(c.delays, d)
+
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+
+	return ch
+}
+
+// blockingClock is a Clock whose timers never fire.
+type blockingClock struct{}
+
+func (blockingClock) After(time.Duration) <-chan time.Time {
+	return nil
+}
+
+// flakyFunc returns a function that fails with TestErrTransient the given number of
+// times for each value before succeeding, and fails permanently for negative values.
+func flakyFunc(failures map[int]int) func(context.Context, int) (int, error) {
+	calls := make(map[int]int)
+
+	return func(_ context.Context, a int) (int, error) {
+		if a < 0 {
+			return 0, TestErrPermanent
+		}
+
+		calls[a]++
+		if calls[a] <= failures[a] {
+			return 0, fmt.Errorf("attempt %d: %w", calls[a], TestErrTransient)
+		}
+
+		return a * 10, nil
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Run("constant", func(t *testing.T) {
+		b := ConstantBackoff(time.Second)
+		for attempt := 1; attempt <= 3; attempt++ {
+			if d := b(attempt); d != time.Second {
+				t.Errorf("Expected 1s for attempt %d, got %v", attempt, d)
+			}
+		}
+	})
+
+	t.Run("exponential", func(t *testing.T) {
+		b := ExponentialBackoff(100*time.Millisecond, time.Second)
+		expected := []time.Duration{
+			100 * time.Millisecond,
+			200 * time.Millisecond,
+			400 * time.Millisecond,
+			800 * time.Millisecond,
+			time.Second,
+			time.Second,
+		}
+
+		for i, want := range expected {
+			if d := b(i + 1); d != want {
+				t.Errorf("Expected %v for attempt %d, got %v", want, i+1, d)
+			}
+		}
+	})
+
+	t.Run("exponential without limit", func(t *testing.T) {
+		b := ExponentialBackoff(time.Second, 0)
+
+		if d := b(11); d != 1024*time.Second {
+			t.Errorf("Expected 1024s, got %v", d)
+		}
+
+		if d := b(1000); d <= 0 {
+			t.Errorf("Expected delay not to overflow, got %v", d)
+		}
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		b := JitterBackoff(ConstantBackoff(time.Second))
+		for i := 0; i < 100; i++ {
+			if d := b(1); d < 0 || d > time.Second {
+				t.Errorf("Expected delay between 0 and 1s, got %v", d)
+			}
+		}
+
+		if d := JitterBackoff(ConstantBackoff(0))(1); d != 0 {
+			t.Errorf("Expected 0, got %v", d)
+		}
+
+		if d := JitterBackoff(ConstantBackoff(math.MaxInt64))(1); d < 0 {
+			t.Errorf("Expected non-negative delay, got %v", d)
+		}
+	})
+}
+
+func TestRetryMap(t *testing.T) {
+	t.Run("retries until success", func(t *testing.T) {
+		clock := &fakeClock{}
+		policy := RetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     ExponentialBackoff(time.Second, 0),
+			Clock:       clock,
+		}
+
+		results, outcomes, err := RetryMap(context.Background(), policy, flakyFunc(map[int]int{2: 2}), []int{1, 2, 3})
+
+		if err != nil {
+			t.Fatalf("Expected nil error, got %v", err)
+		}
+
+		if !slices.Equal(results, []int{10, 20, 30}) {
+			t.Errorf("Expected [10 20 30], got %v", results)
+		}
+
+		expected := []RetryOutcome{{Attempts: 1}, {Attempts: 3}, {Attempts: 1}}
+		if !slices.Equal(outcomes, expected) {
+			t.Errorf("Expected %v, got %v", expected, outcomes)
+		}
+
+		if !slices.Equal(clock.delays, []time.Duration{time.Second, 2 * time.Second}) {
+			t.Errorf("Expected delays [1s 2s], got %v", clock.delays)
+		}
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 2, Clock: &fakeClock{}}
+
+		_, outcomes, err := RetryMap(context.Background(), policy, flakyFunc(map[int]int{1: 5}), []int{1, 2})
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		if !slices.Equal(mapError.Indices(), []int{0}) {
+			t.Errorf("Expected error at index 0, got %v", mapError.Indices())
+		}
+
+		if outcomes[0].Attempts != 2 || !errors.Is(outcomes[0].Err, TestErrTransient) {
+			t.Errorf("Expected 2 attempts ending in transient error, got %+v", outcomes[0])
+		}
+
+		if outcomes[0].Err.Error() != "attempt 2: transient" {
+			t.Errorf("Expected the error of the last attempt, got %v", outcomes[0].Err)
+		}
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		clock := &fakeClock{}
+		policy := RetryPolicy{
+			MaxAttempts: 5,
+			Backoff:     ConstantBackoff(time.Second),
+			Retryable: func(err error) bool {
+				return errors.Is(err, TestErrTransient)
+			},
+			Clock: clock,
+		}
+
+		_, outcomes, err := RetryMap(context.Background(), policy, flakyFunc(map[int]int{1: 1}), []int{-1, 1})
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		if !errors.Is(mapError.Errors[0], TestErrPermanent) {
+			t.Errorf("Expected permanent error at index 0, got %v", mapError.Errors[0])
+		}
+
+		expected := []RetryOutcome{{Attempts: 1, Err: TestErrPermanent}, {Attempts: 2}}
+		if !slices.Equal(outcomes, expected) {
+			t.Errorf("Expected %v, got %v", expected, outcomes)
+		}
+
+		if len(clock.delays) != 1 {
+			t.Errorf("Expected 1 delay, got %v", clock.delays)
+		}
+	})
+
+	t.Run("zero policy makes one attempt", func(t *testing.T) {
+		_, outcomes, err := RetryMap(context.Background(), RetryPolicy{}, flakyFunc(map[int]int{1: 1}), []int{1})
+
+		if err == nil || outcomes[0].Attempts != 1 {
+			t.Errorf("Expected a single failed attempt, got %+v and %v", outcomes[0], err)
+		}
+	})
+
+	t.Run("cancelled while waiting", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		policy := RetryPolicy{
+			MaxAttempts: 5,
+			Backoff:     ConstantBackoff(time.Hour),
+			Clock:       blockingClock{},
+		}
+
+		f := flakyFunc(map[int]int{1: 5})
+		_, outcomes, err := RetryMap(ctx, policy, func(ctx context.Context, a int) (int, error) {
+			cancel()
+			return f(ctx, a)
+		}, []int{1, 2, 3})
+
+		var mapError *MapError
+		if !errors.As(err, &mapError) {
+			t.Fatalf("Expected *MapError, got %v", err)
+		}
+
+		if outcomes[0].Attempts != 1 || !errors.Is(outcomes[0].Err, TestErrTransient) {
+			t.Errorf("Expected 1 attempt ending in transient error, got %+v", outcomes[0])
+		}
+
+		if !slices.Equal(mapError.Skipped(), []int{1, 2}) {
+			t.Errorf("Expected indices [1 2] to be skipped, got %v", mapError.Skipped())
+		}
+
+		if outcomes[2].Attempts != 0 || !errors.Is(outcomes[2].Err, ErrSkipped) {
+			t.Errorf("Expected skipped outcome, got %+v", outcomes[2])
+		}
+	})
+}
+
+func TestRetryApply(t *testing.T) {
+	attempts := 0
+	policy := RetryPolicy{MaxAttempts: 3, Clock: &fakeClock{}}
+
+	outcomes, err := RetryApply(context.Background(), policy, func(_ context.Context, a int) error {
+		attempts++
+		if attempts < 3 {
+			return TestErrTransient
+		}
+		return nil
+	}, []int{1})
+
+	if err != nil {
+		t.Errorf("Expected nil error, got %v", err)
+	}
+
+	if !slices.Equal(outcomes, []RetryOutcome{{Attempts: 3}}) {
+		t.Errorf("Expected 3 attempts, got %v", outcomes)
+	}
+}
+
+func ExampleRetryMap() {
+	calls := 0
+	fetch := func(_ context.Context, id int) (string, error) {
+		calls++
+		if calls == 1 {
+			return "", errors.New("connection reset")
+		}
+		return fmt.Sprintf("item-%d", id), nil
+	}
+
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     JitterBackoff(ExponentialBackoff(time.Millisecond, 10*time.Millisecond)),
+	}
+
+	items, outcomes, err := RetryMap(context.Background(), policy, fetch, []int{1, 2})
+
+	fmt.Println(items, err)
+	for i, o := range outcomes {
+		fmt.Printf("index %d: attempts=%d\n", i, o.Attempts)
+	}
+	// Output:
+	// [item-1 item-2] <nil>
+	// index 0: attempts=2
+	// index 1: attempts=1
+}
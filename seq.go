@@ -0,0 +1,194 @@
+package generics
+
+import "iter"
+
+// FromSlice returns an iterator over the elements of a slice.
+func FromSlice[T any](arr []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range arr {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromMap returns an iterator over the keys and values of a map, in no particular order.
+func FromMap[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// FromChan returns an iterator over the values received from a channel.
+// The iteration ends when the channel is closed or the consumer stops early,
+// in which case any remaining values are left in the channel.
+func FromChan[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers the values of an iterator into a new slice.
+func Collect[T any](seq iter.Seq[T]) []T {
+	result := make([]T, 0)
+
+	for v := range seq {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// CollectPairs gathers the key-value pairs of an iterator into a new slice of Pairs.
+func CollectPairs[K any, V any](seq iter.Seq2[K, V]) []Pair[K, V] {
+	result := make([]Pair[K, V], 0)
+
+	for k, v := range seq {
+		result = append(result, Pair[K, V]{A: k, B: v})
+	}
+
+	return result
+}
+
+// FilterSeq returns an iterator over the values of seq that satisfy the predicate.
+// It is the lazy counterpart of Filter.
+func FilterSeq[A any](seq iter.Seq[A], predicate func(A) bool) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for a := range seq {
+			if predicate(a) && !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq returns an iterator over the results of applying f to each value of seq.
+// It is the lazy counterpart of SafeMap.
+func MapSeq[A any, B any](f func(A) B, seq iter.Seq[A]) iter.Seq[B] {
+	return func(yield func(B) bool) {
+		for a := range seq {
+			if !yield(f(a)) {
+				return
+			}
+		}
+	}
+}
+
+// ReduceSeq applies a function to each value of an iterator, accumulating a single result.
+// It is the counterpart of Reduce for iterators.
+func ReduceSeq[A any, B any](seq iter.Seq[A], initial B, f func(B, A) B) B {
+	result := initial
+
+	for a := range seq {
+		result = f(result, a)
+	}
+
+	return result
+}
+
+// TakeWhile returns an iterator over the leading values of seq that satisfy the predicate.
+// It stops at the first value that does not.
+func TakeWhile[A any](seq iter.Seq[A], predicate func(A) bool) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for a := range seq {
+			if !predicate(a) || !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile returns an iterator over the values of seq that follow its leading values
+// satisfying the predicate. Once a value fails the predicate, every later value is yielded.
+func DropWhile[A any](seq iter.Seq[A], predicate func(A) bool) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		dropping := true
+
+		for a := range seq {
+			if dropping && predicate(a) {
+				continue
+			}
+			dropping = false
+
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns an iterator over at most the first n values of seq.
+func Take[A any](seq iter.Seq[A], n int) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for a := range seq {
+			if !yield(a) {
+				return
+			}
+
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns an iterator over the values of seq after the first n.
+func Skip[A any](seq iter.Seq[A], n int) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		skipped := 0
+
+		for a := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns an iterator over the values of seq paired with their position,
+// starting at zero.
+func Enumerate[A any](seq iter.Seq[A]) iter.Seq2[int, A] {
+	return func(yield func(int, A) bool) {
+		i := 0
+
+		for a := range seq {
+			if !yield(i, a) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Chain returns an iterator over the values of each of seqs in turn.
+func Chain[A any](seqs ...iter.Seq[A]) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for _, seq := range seqs {
+			for a := range seq {
+				if !yield(a) {
+					return
+				}
+			}
+		}
+	}
+}
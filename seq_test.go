@@ -0,0 +1,180 @@
+package generics
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestSeq(t *testing.T) {
+	even := func(a int) bool { return a%2 == 0 }
+	small := func(a int) bool { return a < 3 }
+
+	tests := []struct {
+		name     string
+		seq      iter.Seq[int]
+		expected []int
+	}{
+		{name: "FromSlice", seq: FromSlice([]int{1, 2, 3}), expected: []int{1, 2, 3}},
+		{name: "FromSlice nil", seq: FromSlice[int](nil), expected: []int{}},
+		{name: "FilterSeq", seq: FilterSeq(FromSlice([]int{1, 2, 3, 4, 5}), even), expected: []int{2, 4}},
+		{name: "FilterSeq none", seq: FilterSeq(FromSlice([]int{1, 3}), even), expected: []int{}},
+		{name: "MapSeq", seq: MapSeq(func(a int) int { return a * 2 }, FromSlice([]int{1, 2, 3})), expected: []int{2, 4, 6}},
+		{name: "TakeWhile", seq: TakeWhile(FromSlice([]int{1, 2, 3, 1}), small), expected: []int{1, 2}},
+		{name: "TakeWhile none", seq: TakeWhile(FromSlice([]int{5, 1}), small), expected: []int{}},
+		{name: "DropWhile", seq: DropWhile(FromSlice([]int{1, 2, 3, 1}), small), expected: []int{3, 1}},
+		{name: "DropWhile all", seq: DropWhile(FromSlice([]int{1, 2}), small), expected: []int{}},
+		{name: "Take", seq: Take(FromSlice([]int{1, 2, 3, 4}), 2), expected: []int{1, 2}},
+		{name: "Take more than available", seq: Take(FromSlice([]int{1, 2}), 5), expected: []int{1, 2}},
+		{name: "Take zero", seq: Take(FromSlice([]int{1, 2}), 0), expected: []int{}},
+		{name: "Skip", seq: Skip(FromSlice([]int{1, 2, 3, 4}), 2), expected: []int{3, 4}},
+		{name: "Skip more than available", seq: Skip(FromSlice([]int{1, 2}), 5), expected: []int{}},
+		{name: "Skip negative", seq: Skip(FromSlice([]int{1, 2}), -1), expected: []int{1, 2}},
+		{name: "Chain", seq: Chain(FromSlice([]int{1, 2}), FromSlice[int](nil), FromSlice([]int{3})), expected: []int{1, 2, 3}},
+		{name: "Chain empty", seq: Chain[int](), expected: []int{}},
+		{
+			name:     "Composed",
+			seq:      Take(Skip(FilterSeq(MapSeq(func(a int) int { return a * 3 }, FromSlice([]int{1, 2, 3, 4, 5, 6})), even), 1), 2),
+			expected: []int{12, 18},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Collect(tt.seq)
+
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSeqEarlyStop(t *testing.T) {
+	seqs := map[string]iter.Seq[int]{
+		"FromSlice": FromSlice([]int{1, 2, 3}),
+		"FilterSeq": FilterSeq(FromSlice([]int{1, 2, 3}), func(int) bool { return true }),
+		"MapSeq":    MapSeq(func(a int) int { return a }, FromSlice([]int{1, 2, 3})),
+		"TakeWhile": TakeWhile(FromSlice([]int{1, 2, 3}), func(int) bool { return true }),
+		"DropWhile": DropWhile(FromSlice([]int{1, 2, 3}), func(int) bool { return false }),
+		"Take":      Take(FromSlice([]int{1, 2, 3}), 3),
+		"Skip":      Skip(FromSlice([]int{0, 1, 2, 3}), 1),
+		"Chain":     Chain(FromSlice([]int{1}), FromSlice([]int{2, 3})),
+		"FromChan":  FromChan(bufferedChan(1, 2, 3)),
+	}
+
+	for name, seq := range seqs {
+		t.Run(name, func(t *testing.T) {
+			var got []int
+			for v := range seq {
+				got = append(got, v)
+				if len(got) == 2 {
+					break
+				}
+			}
+
+			if !slices.Equal(got, []int{1, 2}) {
+				t.Errorf("Expected [1 2], got %v", got)
+			}
+		})
+	}
+}
+
+func TestSeqIsLazy(t *testing.T) {
+	calls := 0
+	double := func(a int) int {
+		calls++
+		return a * 2
+	}
+
+	seq := Take(MapSeq(double, FromSlice([]int{1, 2, 3, 4, 5})), 2)
+
+	if calls != 0 {
+		t.Errorf("Expected no calls before iteration, got %d", calls)
+	}
+
+	if got := Collect(seq); !slices.Equal(got, []int{2, 4}) {
+		t.Errorf("Expected [2 4], got %v", got)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	got := CollectPairs(Enumerate(FromSlice([]string{"a", "b", "c"})))
+	expected := []Pair[int, string]{{0, "a"}, {1, "b"}, {2, "c"}}
+
+	if !slices.Equal(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	for i := range Enumerate(FromSlice([]string{"a", "b", "c"})) {
+		if i > 0 {
+			t.Errorf("Expected iteration to stop after index 0, got %d", i)
+		}
+		break
+	}
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	got := make(map[string]int)
+
+	for k, v := range FromMap(m) {
+		got[k] = v
+	}
+
+	if len(got) != len(m) {
+		t.Errorf("Expected %v, got %v", m, got)
+	}
+
+	for k, v := range m {
+		if got[k] != v {
+			t.Errorf("Expected %s=%d, got %d", k, v, got[k])
+		}
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	got := Collect(FromChan(bufferedChan(1, 2, 3)))
+
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+}
+
+func TestReduceSeq(t *testing.T) {
+	sum := ReduceSeq(FromSlice([]int{1, 2, 3, 4, 5}), 0, func(acc, a int) int { return acc + a })
+
+	if sum != 15 {
+		t.Errorf("Expected 15, got %d", sum)
+	}
+
+	if got := ReduceSeq(FromSlice[int](nil), 42, func(acc, a int) int { return acc + a }); got != 42 {
+		t.Errorf("Expected 42, got %d", got)
+	}
+}
+
+func bufferedChan[T any](values ...T) chan T {
+	ch := make(chan T, len(values))
+	for _, v := range values {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+func ExampleFilterSeq() {
+	evens := FilterSeq(FromSlice([]int{1, 2, 3, 4, 5, 6}), func(a int) bool {
+		return a%2 == 0
+	})
+
+	labels := MapSeq(strconv.Itoa, Take(evens, 2))
+
+	fmt.Println(Collect(labels))
+	// Output: [2 4]
+}
@@ -0,0 +1,236 @@
+package generics
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Set is an unordered collection of unique values backed by a map.
+//
+// A Set must be created with NewSet, SetFromSlice or make before values are added;
+// a nil Set behaves as an empty, read-only set. Iterate over a Set with range:
+//
+//	for v := range s {
+//		...
+//	}
+//
+// A Set marshals to JSON as an array in a deterministic order.
+type Set[T comparable] map[T]struct{}
+
+// NewSet creates a Set containing the given items.
+func NewSet[T comparable](items ...T) Set[T] {
+	return SetFromSlice(items)
+}
+
+// SetFromSlice creates a Set containing the elements of a slice.
+func SetFromSlice[T comparable](arr []T) Set[T] {
+	s := make(Set[T], len(arr))
+
+	for _, v := range arr {
+		s[v] = struct{}{}
+	}
+
+	return s
+}
+
+// Add adds the items to the Set.
+func (s Set[T]) Add(items ...T) {
+	for _, v := range items {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove removes the items from the Set.
+func (s Set[T]) Remove(items ...T) {
+	for _, v := range items {
+		delete(s, v)
+	}
+}
+
+// Has returns true if the Set contains v.
+func (s Set[T]) Has(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Len returns the number of items in the Set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Clone returns a copy of the Set.
+func (s Set[T]) Clone() Set[T] {
+	c := make(Set[T], len(s))
+
+	for v := range s {
+		c[v] = struct{}{}
+	}
+
+	return c
+}
+
+// Values returns the items of the Set as a slice in no particular order.
+// Use SortedValues for a sorted slice.
+func (s Set[T]) Values() []T {
+	values := make([]T, 0, len(s))
+
+	for v := range s {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// Union returns a new Set containing the items in either s or other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := s.Clone()
+
+	for v := range other {
+		result[v] = struct{}{}
+	}
+
+	return result
+}
+
+// Intersection returns a new Set containing the items in both s and other.
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+
+	result := make(Set[T])
+
+	for v := range small {
+		if large.Has(v) {
+			result[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// Difference returns a new Set containing the items in s that are not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := make(Set[T])
+
+	for v := range s {
+		if !other.Has(v) {
+			result[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference returns a new Set containing the items in exactly one of s and other.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := s.Difference(other)
+
+	for v := range other {
+		if !s.Has(v) {
+			result[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// IsSubset returns true if every item in s is also in other.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for v := range s {
+		if !other.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset returns true if every item in other is also in s.
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns true if s and other contain exactly the same items.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// String returns a string representation of the Set with its items in sorted order.
+func (s Set[T]) String() string {
+	return fmt.Sprintf("Set%v", s.sorted())
+}
+
+// MarshalJSON encodes the Set as an array. Items of ordered types are sorted by
+// value, and any other items are sorted by their formatted representation.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.sorted())
+}
+
+// UnmarshalJSON decodes an array into the Set, replacing its contents.
+// Duplicate items in the array are ignored.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*s = SetFromSlice(values)
+
+	return nil
+}
+
+// sorted returns the items of the Set in a deterministic order.
+func (s Set[T]) sorted() []T {
+	values := s.Values()
+	slices.SortFunc(values, compareValues[T])
+
+	return values
+}
+
+// compareValues orders two values of the same type. Booleans, numbers and strings
+// are compared by value; anything else is compared by its formatted representation.
+func compareValues[T any](a, b T) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	if va.IsValid() && vb.IsValid() && va.Kind() == vb.Kind() {
+		switch va.Kind() {
+		case reflect.Bool:
+			return cmp.Compare(boolToInt(va.Bool()), boolToInt(vb.Bool()))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(va.Int(), vb.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(va.Uint(), vb.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(va.Float(), vb.Float())
+		case reflect.String:
+			return cmp.Compare(va.String(), vb.String())
+		}
+	}
+
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// SortedValues returns the items of a Set as a slice in ascending order.
+func SortedValues[T cmp.Ordered](s Set[T]) []T {
+	values := s.Values()
+	slices.Sort(values)
+
+	return values
+}
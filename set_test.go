@@ -0,0 +1,196 @@
+package generics
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	s := NewSet(1, 2, 3, 2)
+
+	if s.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", s.Len())
+	}
+
+	s.Add(4, 5)
+	s.Remove(1, 10)
+
+	for _, v := range []int{2, 3, 4, 5} {
+		if !s.Has(v) {
+			t.Errorf("Expected set to contain %d", v)
+		}
+	}
+
+	if s.Has(1) {
+		t.Errorf("Expected set not to contain 1")
+	}
+
+	sum := 0
+	for v := range s {
+		sum += v
+	}
+
+	if sum != 14 {
+		t.Errorf("Expected sum 14, got %d", sum)
+	}
+
+	c := s.Clone()
+	c.Add(100)
+	if s.Has(100) {
+		t.Errorf("Expected clone to be independent")
+	}
+}
+
+func TestSetNil(t *testing.T) {
+	var s Set[string]
+
+	if s.Len() != 0 || s.Has("a") {
+		t.Errorf("Expected nil set to be empty")
+	}
+
+	if !s.IsSubset(NewSet("a")) || !s.Equal(NewSet[string]()) {
+		t.Errorf("Expected nil set to behave as an empty set")
+	}
+
+	if u := s.Union(NewSet("a")); !u.Equal(NewSet("a")) {
+		t.Errorf("Expected {a}, got %v", u)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(3, 4, 5)
+
+	tests := []struct {
+		name     string
+		got      Set[int]
+		expected []int
+	}{
+		{name: "Union", got: a.Union(b), expected: []int{1, 2, 3, 4, 5}},
+		{name: "Intersection", got: a.Intersection(b), expected: []int{3, 4}},
+		{name: "Intersection with empty", got: a.Intersection(NewSet[int]()), expected: []int{}},
+		{name: "Difference", got: a.Difference(b), expected: []int{1, 2}},
+		{name: "Difference reversed", got: b.Difference(a), expected: []int{5}},
+		{name: "SymmetricDifference", got: a.SymmetricDifference(b), expected: []int{1, 2, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SortedValues(tt.got)
+
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	if a.Len() != 4 || b.Len() != 3 {
+		t.Errorf("Expected operands to be unchanged, got %v and %v", a, b)
+	}
+}
+
+func TestSetComparisons(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Set[string]
+		subset   bool
+		superset bool
+		equal    bool
+	}{
+		{name: "Equal", a: NewSet("a", "b"), b: NewSet("b", "a"), subset: true, superset: true, equal: true},
+		{name: "Proper subset", a: NewSet("a"), b: NewSet("a", "b"), subset: true, superset: false, equal: false},
+		{name: "Proper superset", a: NewSet("a", "b"), b: NewSet("a"), subset: false, superset: true, equal: false},
+		{name: "Disjoint", a: NewSet("a"), b: NewSet("b"), subset: false, superset: false, equal: false},
+		{name: "Same length different items", a: NewSet("a", "c"), b: NewSet("a", "b"), subset: false, superset: false, equal: false},
+		{name: "Empty", a: NewSet[string](), b: NewSet("a"), subset: true, superset: false, equal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.IsSubset(tt.b); got != tt.subset {
+				t.Errorf("Expected IsSubset %v, got %v", tt.subset, got)
+			}
+
+			if got := tt.a.IsSuperset(tt.b); got != tt.superset {
+				t.Errorf("Expected IsSuperset %v, got %v", tt.superset, got)
+			}
+
+			if got := tt.a.Equal(tt.b); got != tt.equal {
+				t.Errorf("Expected Equal %v, got %v", tt.equal, got)
+			}
+		})
+	}
+}
+
+func TestSetJSON(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "Ints", value: NewSet(10, 2, 33, 1), expected: `[1,2,10,33]`},
+		{name: "Strings", value: NewSet("b", "c", "a"), expected: `["a","b","c"]`},
+		{name: "Structs", value: NewSet(point{2, 1}, point{1, 2}), expected: `[{"X":1,"Y":2},{"X":2,"Y":1}]`},
+		{name: "Empty", value: NewSet[int](), expected: `[]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Expected nil, got %v", err)
+			}
+
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		var s Set[int]
+		if err := json.Unmarshal([]byte(`[3,1,3,2]`), &s); err != nil {
+			t.Fatalf("Expected nil, got %v", err)
+		}
+
+		if !s.Equal(NewSet(1, 2, 3)) {
+			t.Errorf("Expected {1 2 3}, got %v", s)
+		}
+	})
+
+	t.Run("Unmarshal invalid", func(t *testing.T) {
+		var s Set[int]
+		if err := json.Unmarshal([]byte(`{"a":1}`), &s); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+}
+
+func TestSetFromSlice(t *testing.T) {
+	s := SetFromSlice([]string{"a", "b", "a"})
+
+	if got := SortedValues(s); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("Expected [a b], got %v", got)
+	}
+
+	if got := len(s.Values()); got != 2 {
+		t.Errorf("Expected 2 values, got %d", got)
+	}
+}
+
+func ExampleSet() {
+	seen := NewSet("a", "b")
+	seen.Add("c")
+
+	fmt.Println(seen.Has("a"), seen.Has("z"))
+	fmt.Println(seen.Intersection(NewSet("b", "c", "d")))
+	// Output:
+	// true false
+	// Set[b c]
+}
@@ -8,6 +8,8 @@ package generics
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 var (
@@ -17,6 +19,17 @@ var (
 
 	// ErrNotFound is returned when an element matching a predicate is not found.
 	ErrNotFound = errors.New("not found")
+
+	// ErrDuplicateKey is returned when two elements map to the same key and
+	// duplicates are not allowed.
+	ErrDuplicateKey = errors.New("duplicate key")
+
+	// ErrSkipped is matched by a SkippedError, recorded for an element that was not
+	// processed because its context was done.
+	ErrSkipped = errors.New("skipped")
+
+	// ErrPoolClosed is returned when a job is submitted to a Pool that has been closed.
+	ErrPoolClosed = errors.New("pool closed")
 )
 
 // SafeApply applies a function to each element of a slice.
@@ -47,32 +60,22 @@ func Apply[A any](f func(A) error, arr []A) error {
 	return nil
 }
 
-// MapError is a collection of errors returned by functions applied to slices.
-// It maps the index of the element that caused the error to the error itself.
-type MapError struct {
-	Errors map[int]error
-}
-
-// Error returns a string representation of the MapError.
-func (m *MapError) Error() string {
-	return fmt.Sprintf("%d errors", len(m.Errors))
-}
-
-// HasError returns true if the MapError contains any errors.
-func (m *MapError) HasError() bool {
-	return len(m.Errors) > 0
-}
+// ApplyIndexed is like Apply, but f also receives the index of each element.
+func ApplyIndexed[A any](f func(int, A) error, arr []A) error {
+	err := NewMapError()
 
-// Add adds an error to the MapError at the specified index.
-func (m *MapError) Add(idx int, err error) {
-	m.Errors[idx] = err
-}
+	for i, a := range arr {
+		e := f(i, a)
+		if e != nil {
+			err.Add(i, e)
+		}
+	}
 
-// NewMapError creates a new, empty MapError.
-func NewMapError() *MapError {
-	return &MapError{
-		Errors: make(map[int]error),
+	if err.HasError() {
+		return err
 	}
+
+	return nil
 }
 
 // SafeMap applies a function to each element of a slice and returns a slice of the results.
@@ -108,10 +111,77 @@ func Map[A any, B any](f func(A) (B, error), arr []A) ([]B, error) {
 	return results, nil
 }
 
-// Pair is a simple tuple of two values of possibly different types.
-type Pair[A, B any] struct {
-	A A
-	B B
+// MapIndexed is like Map, but f also receives the index of each element.
+func MapIndexed[A any, B any](f func(int, A) (B, error), arr []A) ([]B, error) {
+	results := make([]B, len(arr))
+
+	err := NewMapError()
+
+	for i, a := range arr {
+		b, e := f(i, a)
+		if e != nil {
+			err.Add(i, e)
+		}
+		results[i] = b
+	}
+
+	if err.HasError() {
+		return results, err
+	}
+
+	return results, nil
+}
+
+// SafeFlatMap applies a function that returns a slice to each element of a slice and
+// concatenates the results into a single slice.
+func SafeFlatMap[A any, B any](f func(A) []B, arr []A) []B {
+	results := make([]B, 0, len(arr))
+
+	for _, a := range arr {
+		results = append(results, f(a)...)
+	}
+
+	return results
+}
+
+// FlatMap applies a function that returns a slice and can return an error to each
+// element of a slice, and concatenates the results into a single slice.
+// Like Map, it keeps the results of every call, including those that returned an
+// error, and returns a MapError keyed by the index of each element whose call failed.
+func FlatMap[A any, B any](f func(A) ([]B, error), arr []A) ([]B, error) {
+	results := make([]B, 0, len(arr))
+
+	err := NewMapError()
+
+	for i, a := range arr {
+		bs, e := f(a)
+		if e != nil {
+			err.Add(i, e)
+		}
+		results = append(results, bs...)
+	}
+
+	if err.HasError() {
+		return results, err
+	}
+
+	return results, nil
+}
+
+// Flatten concatenates a slice of slices into a single slice.
+func Flatten[T any](arr [][]T) []T {
+	n := 0
+	for _, inner := range arr {
+		n += len(inner)
+	}
+
+	result := make([]T, 0, n)
+
+	for _, inner := range arr {
+		result = append(result, inner...)
+	}
+
+	return result
 }
 
 // Compact returns a new slice with all zero values removed.
@@ -129,26 +199,190 @@ func Compact[A comparable](arr []A) []A {
 	return result
 }
 
+// CompactFunc returns a new slice without the elements for which isZero returns true.
+// Unlike Compact, the elements need not be comparable.
+func CompactFunc[A any](arr []A, isZero func(A) bool) []A {
+	result := make([]A, 0)
+
+	for _, a := range arr {
+		if !isZero(a) {
+			result = append(result, a)
+		}
+	}
+
+	return result
+}
+
+// CompactAny returns a new slice with all zero values removed, as decided by IsZeroValue.
+// Unlike Compact, it works with elements that are not comparable, such as structs
+// holding slices or maps.
+func CompactAny[A any](arr []A) []A {
+	return CompactFunc(arr, IsZeroValue[A])
+}
+
+// CompactInPlace removes all zero values from arr without allocating, and returns the
+// shortened slice. It reuses the backing array of arr, whose contents are modified;
+// the elements beyond the new length are set to zero so they can be garbage collected.
+func CompactInPlace[A comparable](arr []A) []A {
+	var zero A
+
+	return CompactFuncInPlace(arr, func(a A) bool {
+		return a == zero
+	})
+}
+
+// CompactFuncInPlace removes the elements for which isZero returns true from arr without
+// allocating, and returns the shortened slice. Like CompactInPlace, it modifies the
+// backing array of arr.
+func CompactFuncInPlace[A any](arr []A, isZero func(A) bool) []A {
+	n := 0
+
+	for _, a := range arr {
+		if !isZero(a) {
+			arr[n] = a
+			n++
+		}
+	}
+
+	clear(arr[n:])
+
+	return arr[:n]
+}
+
+// CompactMap returns a new map without the entries whose values are zero, as decided
+// by IsZeroValue.
+func CompactMap[K comparable, V any](m map[K]V) map[K]V {
+	result := make(map[K]V, len(m))
+
+	for k, v := range m {
+		if !IsZeroValue(v) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// LengthError is returned when slices that must be of equal length are not.
+// It wraps ErrDifferentLength, so it can be matched with errors.Is.
+type LengthError struct {
+	// Lengths holds the length of each slice, in argument order.
+	Lengths []int
+}
+
+// Error returns a string representation of the LengthError.
+func (e *LengthError) Error() string {
+	lengths := make([]string, len(e.Lengths))
+	for i, l := range e.Lengths {
+		lengths[i] = strconv.Itoa(l)
+	}
+
+	return fmt.Sprintf("%v: got lengths %s", ErrDifferentLength, strings.Join(lengths, ", "))
+}
+
+// Unwrap returns ErrDifferentLength.
+func (e *LengthError) Unwrap() error {
+	return ErrDifferentLength
+}
+
 // Zip combines two slices into a single slice of Pairs.
-// It returns ErrDifferentLength if the slices are not the same length.
+// It returns a *LengthError if the slices are not the same length.
 func Zip[A any, B any](a []A, b []B) ([]Pair[A, B], error) {
+	return ZipWith(a, b, func(x A, y B) Pair[A, B] {
+		return Pair[A, B]{A: x, B: y}
+	})
+}
+
+// ZipWith combines two slices into a single slice by applying f to the elements
+// at each index. It returns a *LengthError if the slices are not the same length.
+func ZipWith[A any, B any, C any](a []A, b []B, f func(A, B) C) ([]C, error) {
 	if len(a) != len(b) {
-		return nil, ErrDifferentLength
+		return nil, &LengthError{Lengths: []int{len(a), len(b)}}
 	}
 
-	result := make([]Pair[A, B], len(a))
+	result := make([]C, len(a))
 
 	for i := 0; i < len(a); i++ {
-		pair := Pair[A, B]{
-			A: a[i],
-			B: b[i],
+		result[i] = f(a[i], b[i])
+	}
+
+	return result, nil
+}
+
+// ZipShortest combines two slices into a single slice of Pairs, stopping at the end
+// of the shorter slice.
+func ZipShortest[A any, B any](a []A, b []B) []Pair[A, B] {
+	n := min(len(a), len(b))
+	result, _ := Zip(a[:n], b[:n])
+
+	return result
+}
+
+// ZipLongest combines two slices into a single slice of Pairs as long as the longer
+// slice. Missing elements of the shorter slice are replaced by padA or padB.
+func ZipLongest[A any, B any](a []A, b []B, padA A, padB B) []Pair[A, B] {
+	n := max(len(a), len(b))
+	result := make([]Pair[A, B], n)
+
+	for i := 0; i < n; i++ {
+		result[i] = Pair[A, B]{A: padA, B: padB}
+		if i < len(a) {
+			result[i].A = a[i]
 		}
-		result[i] = pair
+		if i < len(b) {
+			result[i].B = b[i]
+		}
+	}
+
+	return result
+}
+
+// Zip3 combines three slices into a single slice of Triples.
+// It returns a *LengthError if the slices are not all the same length.
+func Zip3[A any, B any, C any](a []A, b []B, c []C) ([]Triple[A, B, C], error) {
+	if len(a) != len(b) || len(a) != len(c) {
+		return nil, &LengthError{Lengths: []int{len(a), len(b), len(c)}}
+	}
+
+	result := make([]Triple[A, B, C], len(a))
+
+	for i := 0; i < len(a); i++ {
+		result[i] = Triple[A, B, C]{A: a[i], B: b[i], C: c[i]}
 	}
 
 	return result, nil
 }
 
+// Unzip splits a slice of Pairs into a slice of their first values and a slice of
+// their second values. It is the inverse of Zip.
+func Unzip[A any, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+
+	for i, p := range pairs {
+		a[i] = p.A
+		b[i] = p.B
+	}
+
+	return a, b
+}
+
+// Unzip3 splits a slice of Triples into three slices of their values.
+// It is the inverse of Zip3.
+func Unzip3[A any, B any, C any](triples []Triple[A, B, C]) ([]A, []B, []C) {
+	a := make([]A, len(triples))
+	b := make([]B, len(triples))
+	c := make([]C, len(triples))
+
+	for i, t := range triples {
+		a[i] = t.A
+		b[i] = t.B
+		c[i] = t.C
+	}
+
+	return a, b, c
+}
+
 // SelectOne returns the first element in a slice that satisfies the predicate.
 // If no such element is found, it returns the zero value and ErrNotFound.
 func SelectOne[T any](arr []T, f func(T) bool) (T, error) {
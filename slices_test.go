@@ -3,6 +3,8 @@ package generics
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -133,6 +135,144 @@ func TestSafeMap(t *testing.T) {
 	})
 }
 
+func TestApplyIndexed(t *testing.T) {
+	arr := []int{2, 3, 4, 5}
+	var seen []int
+
+	err := ApplyIndexed(func(i int, a int) error {
+		seen = append(seen, i)
+		if a%2 != 0 {
+			return fmt.Errorf("element %d: %w", i, TestErrNotEven)
+		}
+		return nil
+	}, arr)
+
+	var mapError *MapError
+	if !errors.As(err, &mapError) {
+		t.Fatalf("Expected MapError, got %v", err)
+	}
+
+	if !slices.Equal(mapError.Indices(), []int{1, 3}) {
+		t.Errorf("Expected errors at indices [1 3], got %v", mapError.Indices())
+	}
+
+	if !slices.Equal(seen, []int{0, 1, 2, 3}) {
+		t.Errorf("Expected every index to be visited, got %v", seen)
+	}
+
+	if err := ApplyIndexed(func(int, int) error { return nil }, arr); err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+}
+
+func TestMapIndexed(t *testing.T) {
+	arr := []string{"a", "b", "c"}
+
+	labelled, err := MapIndexed(func(i int, s string) (string, error) {
+		if s == "b" {
+			return "", TestErrOther
+		}
+		return fmt.Sprintf("%d:%s", i, s), nil
+	}, arr)
+
+	var mapError *MapError
+	if !errors.As(err, &mapError) {
+		t.Fatalf("Expected MapError, got %v", err)
+	}
+
+	if !slices.Equal(mapError.Indices(), []int{1}) {
+		t.Errorf("Expected error at index 1, got %v", mapError.Indices())
+	}
+
+	if !slices.Equal(labelled, []string{"0:a", "", "2:c"}) {
+		t.Errorf("Expected [0:a  2:c], got %q", labelled)
+	}
+
+	labelled, err = MapIndexed(func(i int, s string) (string, error) {
+		return fmt.Sprintf("%d:%s", i, s), nil
+	}, arr)
+
+	if err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+
+	if !slices.Equal(labelled, []string{"0:a", "1:b", "2:c"}) {
+		t.Errorf("Expected [0:a 1:b 2:c], got %q", labelled)
+	}
+}
+
+func TestSafeFlatMap(t *testing.T) {
+	arr := []int{1, 2, 3}
+	repeated := SafeFlatMap(func(a int) []int {
+		return slices.Repeat([]int{a}, a)
+	}, arr)
+
+	if !slices.Equal(repeated, []int{1, 2, 2, 3, 3, 3}) {
+		t.Errorf("Expected [1 2 2 3 3 3], got %v", repeated)
+	}
+
+	if got := SafeFlatMap(func(a int) []int { return nil }, arr); got == nil || len(got) != 0 {
+		t.Errorf("Expected empty slice, got %v", got)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	arr := []int{1, 2, 3, 4}
+	result, err := FlatMap(func(a int) ([]int, error) {
+		if a%2 != 0 {
+			return []int{-a}, TestErrNotEven
+		}
+		return []int{a, a * 10}, nil
+	}, arr)
+
+	var mapError *MapError
+	if !errors.As(err, &mapError) {
+		t.Fatalf("Expected MapError, got %v", err)
+	}
+
+	if !slices.Equal(mapError.Indices(), []int{0, 2}) {
+		t.Errorf("Expected errors at indices [0 2], got %v", mapError.Indices())
+	}
+
+	if !slices.Equal(result, []int{-1, 2, 20, -3, 4, 40}) {
+		t.Errorf("Expected [-1 2 20 -3 4 40], got %v", result)
+	}
+
+	result, err = FlatMap(func(a int) ([]int, error) {
+		return []int{a}, nil
+	}, arr)
+
+	if err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+
+	if !slices.Equal(result, arr) {
+		t.Errorf("Expected %v, got %v", arr, result)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name     string
+		arr      [][]int
+		expected []int
+	}{
+		{name: "Nested", arr: [][]int{{1, 2}, {}, nil, {3}}, expected: []int{1, 2, 3}},
+		{name: "Empty", arr: [][]int{}, expected: []int{}},
+		{name: "Nil", arr: nil, expected: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Flatten(tt.arr)
+
+			if result == nil || !slices.Equal(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
 type ZipTest[A comparable, B comparable] struct {
 	name        string
 	arr1        []A
@@ -183,13 +323,134 @@ func TestZipInt(t *testing.T) {
 			arr1:        []int{1, 2, 3, 4, 5},
 			arr2:        []int{5, 4, 3, 2},
 			expected:    nil,
-			expectedErr: fmt.Errorf("arrays must be of equal length"),
+			expectedErr: fmt.Errorf("arrays must be of equal length: got lengths 5, 4"),
 		},
 	}
 
 	zipTestHelper(t, tests)
 }
 
+func TestZipLengthError(t *testing.T) {
+	_, err := Zip([]int{1, 2, 3}, []string{"a"})
+
+	if !errors.Is(err, ErrDifferentLength) {
+		t.Errorf("Expected ErrDifferentLength, got %v", err)
+	}
+
+	var lengthError *LengthError
+	if !errors.As(err, &lengthError) {
+		t.Fatalf("Expected *LengthError, got %v", err)
+	}
+
+	if len(lengthError.Lengths) != 2 || lengthError.Lengths[0] != 3 || lengthError.Lengths[1] != 1 {
+		t.Errorf("Expected lengths [3 1], got %v", lengthError.Lengths)
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	sums, err := ZipWith([]int{1, 2, 3}, []int{10, 20, 30}, func(a, b int) int { return a + b })
+
+	if err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+
+	if !slices.Equal(sums, []int{11, 22, 33}) {
+		t.Errorf("Expected [11 22 33], got %v", sums)
+	}
+
+	_, err = ZipWith([]int{1}, []int{}, func(a, b int) int { return a + b })
+	if err == nil || err.Error() != "arrays must be of equal length: got lengths 1, 0" {
+		t.Errorf("Expected length error, got %v", err)
+	}
+}
+
+func TestZipShortestAndLongest(t *testing.T) {
+	tests := []struct {
+		name            string
+		a               []int
+		b               []string
+		expectedShort   []Pair[int, string]
+		expectedLongest []Pair[int, string]
+	}{
+		{
+			name:            "Equal lengths",
+			a:               []int{1, 2},
+			b:               []string{"a", "b"},
+			expectedShort:   []Pair[int, string]{{1, "a"}, {2, "b"}},
+			expectedLongest: []Pair[int, string]{{1, "a"}, {2, "b"}},
+		},
+		{
+			name:            "First longer",
+			a:               []int{1, 2, 3},
+			b:               []string{"a"},
+			expectedShort:   []Pair[int, string]{{1, "a"}},
+			expectedLongest: []Pair[int, string]{{1, "a"}, {2, "-"}, {3, "-"}},
+		},
+		{
+			name:            "Second longer",
+			a:               []int{1},
+			b:               []string{"a", "b"},
+			expectedShort:   []Pair[int, string]{{1, "a"}},
+			expectedLongest: []Pair[int, string]{{1, "a"}, {-1, "b"}},
+		},
+		{
+			name:            "Nil",
+			a:               nil,
+			b:               []string{"a"},
+			expectedShort:   []Pair[int, string]{},
+			expectedLongest: []Pair[int, string]{{-1, "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ZipShortest(tt.a, tt.b); !slices.Equal(got, tt.expectedShort) {
+				t.Errorf("ZipShortest: expected %v, got %v", tt.expectedShort, got)
+			}
+
+			if got := ZipLongest(tt.a, tt.b, -1, "-"); !slices.Equal(got, tt.expectedLongest) {
+				t.Errorf("ZipLongest: expected %v, got %v", tt.expectedLongest, got)
+			}
+		})
+	}
+}
+
+func TestZip3(t *testing.T) {
+	triples, err := Zip3([]int{1, 2}, []string{"a", "b"}, []bool{true, false})
+
+	if err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+
+	expected := []Triple[int, string, bool]{{1, "a", true}, {2, "b", false}}
+	if !slices.Equal(triples, expected) {
+		t.Errorf("Expected %v, got %v", expected, triples)
+	}
+
+	a, b, c := Unzip3(triples)
+	if !slices.Equal(a, []int{1, 2}) || !slices.Equal(b, []string{"a", "b"}) || !slices.Equal(c, []bool{true, false}) {
+		t.Errorf("Expected original slices, got %v %v %v", a, b, c)
+	}
+
+	_, err = Zip3([]int{1, 2}, []string{"a", "b"}, []bool{true})
+	if !errors.Is(err, ErrDifferentLength) || err.Error() != "arrays must be of equal length: got lengths 2, 2, 1" {
+		t.Errorf("Expected length error, got %v", err)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip([]Pair[int, string]{{1, "a"}, {2, "b"}})
+
+	if !slices.Equal(a, []int{1, 2}) || !slices.Equal(b, []string{"a", "b"}) {
+		t.Errorf("Expected [1 2] [a b], got %v %v", a, b)
+	}
+
+	a, b = Unzip[int, string](nil)
+	if len(a) != 0 || len(b) != 0 {
+		t.Errorf("Expected empty slices, got %v %v", a, b)
+	}
+}
+
 type CompactTest[T comparable] struct {
 	name     string
 	arr      []T
@@ -260,6 +521,142 @@ func TestCompactWithErrors(t *testing.T) {
 	compactTestHelper[error](t, tests)
 }
 
+type compactTestRecord struct {
+	Name string
+	Tags []string
+}
+
+func TestCompactFunc(t *testing.T) {
+	arr := []string{"a", " ", "b", "", "  "}
+
+	compacted := CompactFunc(arr, func(s string) bool {
+		return strings.TrimSpace(s) == ""
+	})
+
+	if !slices.Equal(compacted, []string{"a", "b"}) {
+		t.Errorf("Expected [a b], got %q", compacted)
+	}
+
+	if len(arr) != 5 || arr[1] != " " {
+		t.Errorf("Expected input to be unchanged, got %q", arr)
+	}
+}
+
+func TestCompactAny(t *testing.T) {
+	arr := []compactTestRecord{
+		{Name: "a"},
+		{},
+		{Tags: []string{"x"}},
+		{Tags: []string{}},
+	}
+
+	compacted := CompactAny(arr)
+
+	if len(compacted) != 3 {
+		t.Fatalf("Expected 3 elements, got %v", compacted)
+	}
+
+	if compacted[0].Name != "a" || compacted[1].Tags[0] != "x" || compacted[2].Tags == nil {
+		t.Errorf("Expected zero records to be removed, got %v", compacted)
+	}
+
+	if got := CompactAny([][]int{nil, {1}, nil}); len(got) != 1 {
+		t.Errorf("Expected nil slices to be removed, got %v", got)
+	}
+}
+
+func TestCompactInPlace(t *testing.T) {
+	arr := []int{1, 0, 2, 0, 3}
+
+	compacted := CompactInPlace(arr)
+
+	if !slices.Equal(compacted, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", compacted)
+	}
+
+	if &compacted[0] != &arr[0] {
+		t.Errorf("Expected backing array to be reused")
+	}
+
+	if !slices.Equal(arr, []int{1, 2, 3, 0, 0}) {
+		t.Errorf("Expected tail to be cleared, got %v", arr)
+	}
+
+	if got := CompactInPlace[int](nil); len(got) != 0 {
+		t.Errorf("Expected empty slice, got %v", got)
+	}
+}
+
+func TestCompactFuncInPlace(t *testing.T) {
+	a, b := 1, 2
+	arr := []*int{&a, nil, &b, nil}
+
+	compacted := CompactFuncInPlace(arr, func(p *int) bool {
+		return p == nil
+	})
+
+	if len(compacted) != 2 || compacted[0] != &a || compacted[1] != &b {
+		t.Errorf("Expected non-nil pointers, got %v", compacted)
+	}
+
+	if arr[2] != nil || arr[3] != nil {
+		t.Errorf("Expected tail to be cleared, got %v", arr)
+	}
+}
+
+func TestCompactMap(t *testing.T) {
+	m := map[string][]int{
+		"nil":   nil,
+		"empty": {},
+		"one":   {1},
+	}
+
+	compacted := CompactMap(m)
+
+	if len(compacted) != 2 {
+		t.Errorf("Expected 2 entries, got %v", compacted)
+	}
+
+	if _, ok := compacted["nil"]; ok {
+		t.Errorf("Expected nil entry to be removed")
+	}
+
+	if len(m) != 3 {
+		t.Errorf("Expected input to be unchanged, got %v", m)
+	}
+}
+
+func BenchmarkCompactInPlace(b *testing.B) {
+	src := make([]int, 1024)
+	for i := range src {
+		src[i] = i % 3
+	}
+	arr := make([]int, len(src))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(arr, src)
+		CompactInPlace(arr)
+	}
+}
+
+func ExampleCompactAny() {
+	type Entry struct {
+		Name   string
+		Labels map[string]string
+	}
+
+	entries := []Entry{
+		{Name: "a"},
+		{},
+		{Labels: map[string]string{"env": "prod"}},
+	}
+
+	fmt.Println(len(CompactAny(entries)))
+	// Output:
+	// 2
+}
+
 func TestSelectOne(t *testing.T) {
 	t.Run("found", func(t *testing.T) {
 		arr := []int{1, 2, 3, 4, 5}
@@ -302,6 +699,15 @@ func ExampleMap() {
 	// Output: [2 4 6 8 10]
 }
 
+func ExampleMapIndexed() {
+	ranked, _ := MapIndexed(func(i int, name string) (string, error) {
+		return fmt.Sprintf("%d. %s", i+1, name), nil
+	}, []string{"alice", "bob"})
+
+	fmt.Println(strings.Join(ranked, ", "))
+	// Output: 1. alice, 2. bob
+}
+
 func ExampleApply() {
 	arr := []int{1, 2, 3, 4, 5}
 	err := Apply(func(a int) error {
@@ -315,7 +721,7 @@ func ExampleApply() {
 		fmt.Println(err)
 	}
 
-	// Output: 3 errors
+	// Output: 3 errors: index 0: testErr; index 2: testErr; index 4: testErr
 }
 
 func ExampleZip() {
@@ -329,7 +735,30 @@ func ExampleZip() {
 	}
 
 	fmt.Println(zipped)
-	// Output: [{1 5} {2 4} {3 3} {4 2} {5 1}]
+	// Output: [(1, 5) (2, 4) (3, 3) (4, 2) (5, 1)]
+}
+
+func ExampleZipLongest() {
+	names := []string{"a", "b", "c"}
+	scores := []int{90, 80}
+
+	fmt.Println(ZipLongest(names, scores, "", -1))
+	// Output: [(a, 90) (b, 80) (c, -1)]
+}
+
+func ExampleFlatMap() {
+	words, err := FlatMap(func(s string) ([]string, error) {
+		if s == "" {
+			return nil, errors.New("empty line")
+		}
+		return strings.Fields(s), nil
+	}, []string{"a b", "", "c"})
+
+	fmt.Println(words)
+	fmt.Println(err)
+	// Output:
+	// [a b c]
+	// 1 error: index 1: empty line
 }
 
 func ExampleCompact() {
@@ -0,0 +1,177 @@
+package generics
+
+import (
+	"iter"
+	"slices"
+)
+
+// Stream is a lazy, chainable pipeline over a sequence of values.
+//
+// Intermediate operations such as Filter, Map and Limit only describe the pipeline;
+// nothing runs until a terminal operation such as ToSlice or Count is called. The
+// operations are fused, so a chain makes a single pass over the source, except for
+// Sort which must see every value before yielding the first.
+//
+// Methods can only keep the element type. Use MapStream and FlatMapStream for steps
+// that change it, and DistinctStream and DistinctByStream for steps that need
+// comparable values or keys.
+//
+// A Stream must be created with StreamOf or StreamFrom.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// StreamOf returns a Stream over the elements of a slice.
+func StreamOf[T any](arr []T) Stream[T] {
+	return Stream[T]{seq: FromSlice(arr)}
+}
+
+// StreamFrom returns a Stream over the values of an iterator.
+func StreamFrom[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// All returns an iterator over the values of the Stream.
+func (s Stream[T]) All() iter.Seq[T] {
+	return s.seq
+}
+
+// Filter keeps only the values that satisfy the predicate.
+func (s Stream[T]) Filter(predicate func(T) bool) Stream[T] {
+	return Stream[T]{seq: FilterSeq(s.seq, predicate)}
+}
+
+// Map replaces each value with the result of f.
+func (s Stream[T]) Map(f func(T) T) Stream[T] {
+	return Stream[T]{seq: MapSeq(f, s.seq)}
+}
+
+// Sort orders the values using cmp, which returns a negative number when a < b,
+// a positive number when a > b and zero when they are equal. The sort is stable.
+func (s Stream[T]) Sort(cmp func(a, b T) int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		values := Collect(s.seq)
+		slices.SortStableFunc(values, cmp)
+
+		for _, v := range values {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Limit keeps at most the first n values.
+func (s Stream[T]) Limit(n int) Stream[T] {
+	return Stream[T]{seq: Take(s.seq, n)}
+}
+
+// Skip discards the first n values.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return Stream[T]{seq: Skip(s.seq, n)}
+}
+
+// Peek calls f with each value as it passes through the Stream, without changing it.
+// It is useful for logging or debugging a pipeline.
+func (s Stream[T]) Peek(f func(T)) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			f(v)
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// ToSlice runs the Stream and returns its values as a new slice.
+func (s Stream[T]) ToSlice() []T {
+	return Collect(s.seq)
+}
+
+// Reduce runs the Stream, accumulating a single result of the same type as its values.
+// Use ReduceStream to accumulate a result of a different type.
+func (s Stream[T]) Reduce(initial T, f func(T, T) T) T {
+	return ReduceSeq(s.seq, initial, f)
+}
+
+// Count runs the Stream and returns the number of values.
+func (s Stream[T]) Count() int {
+	n := 0
+
+	for range s.seq {
+		n++
+	}
+
+	return n
+}
+
+// AnyMatch returns true if any value satisfies the predicate.
+// It stops running the Stream at the first match.
+func (s Stream[T]) AnyMatch(predicate func(T) bool) bool {
+	for v := range s.seq {
+		if predicate(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllMatch returns true if every value satisfies the predicate, including when the
+// Stream is empty. It stops running the Stream at the first value that does not.
+func (s Stream[T]) AllMatch(predicate func(T) bool) bool {
+	for v := range s.seq {
+		if !predicate(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// First runs the Stream until its first value and returns it, or None if it is empty.
+func (s Stream[T]) First() Option[T] {
+	for v := range s.seq {
+		return Some(v)
+	}
+
+	return None[T]()
+}
+
+// MapStream returns a Stream that replaces each value of s with the result of f.
+func MapStream[A any, B any](s Stream[A], f func(A) B) Stream[B] {
+	return Stream[B]{seq: MapSeq(f, s.seq)}
+}
+
+// DistinctStream returns a Stream that removes repeated values of s, keeping the first
+// occurrence of each.
+func DistinctStream[T comparable](s Stream[T]) Stream[T] {
+	return Stream[T]{seq: DistinctSeq(s.seq)}
+}
+
+// DistinctByStream returns a Stream that keeps only the first value of s for each key
+// returned by key. Use it to de-duplicate values that are not comparable.
+func DistinctByStream[T any, K comparable](s Stream[T], key func(T) K) Stream[T] {
+	return Stream[T]{seq: DistinctBySeq(s.seq, key)}
+}
+
+// FlatMapStream returns a Stream that replaces each value of s with the elements of
+// the slice returned by f.
+func FlatMapStream[A any, B any](s Stream[A], f func(A) []B) Stream[B] {
+	return Stream[B]{seq: func(yield func(B) bool) {
+		for a := range s.seq {
+			for _, b := range f(a) {
+				if !yield(b) {
+					return
+				}
+			}
+		}
+	}}
+}
+
+// ReduceStream runs the Stream, accumulating a single result that may be of a
+// different type from its values.
+func ReduceStream[A any, B any](s Stream[A], initial B, f func(B, A) B) B {
+	return ReduceSeq(s.seq, initial, f)
+}
@@ -0,0 +1,194 @@
+package generics
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	even := func(a int) bool { return a%2 == 0 }
+	double := func(a int) int { return a * 2 }
+
+	tests := []struct {
+		name     string
+		stream   Stream[int]
+		expected []int
+	}{
+		{name: "Source", stream: StreamOf([]int{1, 2, 3}), expected: []int{1, 2, 3}},
+		{name: "Nil source", stream: StreamOf[int](nil), expected: []int{}},
+		{name: "Filter", stream: StreamOf([]int{1, 2, 3, 4}).Filter(even), expected: []int{2, 4}},
+		{name: "Map", stream: StreamOf([]int{1, 2, 3}).Map(double), expected: []int{2, 4, 6}},
+		{name: "Sort", stream: StreamOf([]int{3, 1, 2}).Sort(cmp.Compare[int]), expected: []int{1, 2, 3}},
+		{name: "Distinct", stream: DistinctStream(StreamOf([]int{3, 1, 3, 2, 1})), expected: []int{3, 1, 2}},
+		{
+			name:     "DistinctBy",
+			stream:   DistinctByStream(StreamOf([]int{3, 1, 4, 2, 5}), func(a int) bool { return a%2 == 0 }),
+			expected: []int{3, 4},
+		},
+		{name: "Limit", stream: StreamOf([]int{1, 2, 3}).Limit(2), expected: []int{1, 2}},
+		{name: "Skip", stream: StreamOf([]int{1, 2, 3}).Skip(2), expected: []int{3}},
+		{
+			name: "Chained",
+			stream: DistinctStream(StreamOf([]int{5, 3, 8, 1, 4, 8, 2}).
+				Filter(func(a int) bool { return a > 1 })).
+				Sort(cmp.Compare[int]).
+				Map(double).
+				Skip(1).
+				Limit(3),
+			expected: []int{6, 8, 10},
+		},
+		{name: "From iterator", stream: StreamFrom(Take(FromSlice([]int{1, 2, 3}), 2)), expected: []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.stream.ToSlice()
+
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestStreamTerminals(t *testing.T) {
+	s := StreamOf([]int{1, 2, 3, 4, 5})
+	empty := StreamOf([]int{})
+	even := func(a int) bool { return a%2 == 0 }
+	large := func(a int) bool { return a > 10 }
+	positive := func(a int) bool { return a > 0 }
+
+	if got := s.Reduce(0, func(acc, a int) int { return acc + a }); got != 15 {
+		t.Errorf("Expected Reduce 15, got %d", got)
+	}
+
+	if got := s.Count(); got != 5 {
+		t.Errorf("Expected Count 5, got %d", got)
+	}
+
+	if got := s.Filter(even).Count(); got != 2 {
+		t.Errorf("Expected Count 2, got %d", got)
+	}
+
+	tests := []struct {
+		name     string
+		got      bool
+		expected bool
+	}{
+		{name: "AnyMatch true", got: s.AnyMatch(even), expected: true},
+		{name: "AnyMatch false", got: s.AnyMatch(large), expected: false},
+		{name: "AnyMatch empty", got: empty.AnyMatch(even), expected: false},
+		{name: "AllMatch true", got: s.AllMatch(positive), expected: true},
+		{name: "AllMatch false", got: s.AllMatch(even), expected: false},
+		{name: "AllMatch empty", got: empty.AllMatch(even), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.got)
+			}
+		})
+	}
+
+	if got := s.Filter(even).First(); got != Some(2) {
+		t.Errorf("Expected Some(2), got %v", got)
+	}
+
+	if got := s.Filter(large).First(); got != None[int]() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}
+
+func TestStreamFusion(t *testing.T) {
+	var trace []string
+
+	got := StreamOf([]int{1, 2, 3, 4, 5, 6}).
+		Peek(func(a int) { trace = append(trace, "peek "+strconv.Itoa(a)) }).
+		Filter(func(a int) bool {
+			trace = append(trace, "filter "+strconv.Itoa(a))
+			return a%2 == 0
+		}).
+		Map(func(a int) int {
+			trace = append(trace, "map "+strconv.Itoa(a))
+			return a * 10
+		}).
+		Limit(1).
+		ToSlice()
+
+	if !slices.Equal(got, []int{20}) {
+		t.Errorf("Expected [20], got %v", got)
+	}
+
+	expected := []string{"peek 1", "filter 1", "peek 2", "filter 2", "map 2"}
+	if !slices.Equal(trace, expected) {
+		t.Errorf("Expected a single interleaved pass %v, got %v", expected, trace)
+	}
+}
+
+func TestStreamAnyMatchStopsEarly(t *testing.T) {
+	visited := 0
+	StreamOf([]int{1, 2, 3, 4}).
+		Peek(func(int) { visited++ }).
+		AnyMatch(func(a int) bool { return a == 2 })
+
+	if visited != 2 {
+		t.Errorf("Expected 2 values to be visited, got %d", visited)
+	}
+}
+
+func TestDistinctByStreamNonComparable(t *testing.T) {
+	groups := [][]int{{1, 2}, {3}, {1, 2}, {}}
+
+	got := DistinctByStream(StreamOf(groups), func(g []int) string {
+		return fmt.Sprint(g)
+	}).ToSlice()
+
+	if len(got) != 3 || !slices.Equal(got[0], []int{1, 2}) || !slices.Equal(got[1], []int{3}) || len(got[2]) != 0 {
+		t.Errorf("Expected [[1 2] [3] []], got %v", got)
+	}
+}
+
+func TestMapStream(t *testing.T) {
+	got := MapStream(StreamOf([]int{1, 2, 3}), strconv.Itoa).ToSlice()
+
+	if !slices.Equal(got, []string{"1", "2", "3"}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+
+	flat := FlatMapStream(StreamOf([]int{1, 2, 3}), func(a int) []int {
+		return slices.Repeat([]int{a}, a)
+	}).Limit(4).ToSlice()
+
+	if !slices.Equal(flat, []int{1, 2, 2, 3}) {
+		t.Errorf("Expected [1 2 2 3], got %v", flat)
+	}
+
+	total := ReduceStream(StreamOf([]string{"a", "bb", "ccc"}), 0, func(acc int, s string) int {
+		return acc + len(s)
+	})
+
+	if total != 6 {
+		t.Errorf("Expected 6, got %d", total)
+	}
+}
+
+func ExampleStream() {
+	words := []string{"pear", "fig", "apple", "kiwi", "fig", "banana"}
+
+	short := DistinctStream(StreamOf(words).
+		Filter(func(w string) bool { return len(w) <= 4 })).
+		Sort(cmp.Compare[string]).
+		ToSlice()
+
+	lengths := MapStream(StreamOf(short), func(w string) int { return len(w) }).ToSlice()
+
+	fmt.Println(short)
+	fmt.Println(lengths)
+	// Output:
+	// [fig kiwi pear]
+	// [3 4 4]
+}
@@ -1,14 +1,366 @@
 package generics
 
-import "reflect"
+import (
+	"reflect"
+	"sync"
+)
+
+// zeroer is implemented by types that define their own notion of a zero value,
+// such as time.Time.
+type zeroer interface {
+	IsZero() bool
+}
 
 // IsZeroValue returns true if the value v is the zero value of its type.
 // It handles nil interfaces, pointers, slices, and maps, as well as primitive types and structs.
+//
+// If v has an IsZero() bool method, such as time.Time, the method decides.
+// Pointers are zero only when nil, and an empty but non-nil slice or map is not zero.
+//
+// Primitive types are checked without reflection, and the zero value of other types
+// is computed once per type and cached.
 func IsZeroValue[T any](v T) bool {
-	t := reflect.TypeOf(v)
-	// Handle nil interfaces
-	if t == nil {
+	a := any(v)
+
+	switch x := a.(type) {
+	case nil:
+		return true
+	case string:
+		return x == ""
+	case bool:
+		return !x
+	case int:
+		return x == 0
+	case int8:
+		return x == 0
+	case int16:
+		return x == 0
+	case int32:
+		return x == 0
+	case int64:
+		return x == 0
+	case uint:
+		return x == 0
+	case uint8:
+		return x == 0
+	case uint16:
+		return x == 0
+	case uint32:
+		return x == 0
+	case uint64:
+		return x == 0
+	case uintptr:
+		return x == 0
+	case float32:
+		return x == 0
+	case float64:
+		return x == 0
+	case complex64:
+		return x == 0
+	case complex128:
+		return x == 0
+	case zeroer:
+		return isZeroer(x)
+	}
+
+	return isZeroReflect(a)
+}
+
+// isZeroer calls IsZero on z. A pointer is only zero when it is nil, so IsZero
+// is not called through pointers.
+//
+// Some IsZero methods panic on their own zero value, such as that of reflect.Value,
+// so a panic falls back to comparing z with the zero value of its type.
+func isZeroer(z zeroer) (zero bool) {
+	if rv := reflect.ValueOf(z); rv.Kind() == reflect.Pointer {
+		return rv.IsNil()
+	}
+
+	defer func() {
+		if recover() != nil {
+			zero = isZeroReflect(z)
+		}
+	}()
+
+	return z.IsZero()
+}
+
+// isZeroReflect reports whether v, which is not nil, equals the zero value of its type.
+func isZeroReflect(v any) bool {
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return rv.IsNil()
+	}
+
+	info := zeroInfoFor(rv.Type())
+	if info.comparable {
+		return v == info.zero
+	}
+
+	return reflect.DeepEqual(v, info.zero)
+}
+
+// zeroInfo describes the zero value of a type.
+type zeroInfo struct {
+	// zero is the zero value of the type.
+	zero any
+
+	// comparable is true if values of the type can always be compared with ==.
+	comparable bool
+}
+
+// zeroInfoCache maps a reflect.Type to its *zeroInfo.
+var zeroInfoCache sync.Map
+
+func zeroInfoFor(t reflect.Type) *zeroInfo {
+	if info, ok := zeroInfoCache.Load(t); ok {
+		return info.(*zeroInfo)
+	}
+
+	info := &zeroInfo{
+		zero:       reflect.Zero(t).Interface(),
+		comparable: t.Comparable() && !containsInterface(t),
+	}
+
+	actual, _ := zeroInfoCache.LoadOrStore(t, info)
+
+	return actual.(*zeroInfo)
+}
+
+// containsInterface reports whether t is or contains an interface type. Such types
+// are comparable, but comparing them panics if the dynamic value is not.
+func containsInterface(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return containsInterface(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if containsInterface(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ZeroOption configures the zero-value semantics of IsZeroValueWith and ZeroFields.
+type ZeroOption func(*zeroOptions)
+
+type zeroOptions struct {
+	emptyAsZero      bool
+	recurseStructs   bool
+	derefPointers    bool
+	ignoreUnexported bool
+}
+
+// TreatEmptyAsZero treats empty slices and maps as zero, even when they are not nil.
+func TreatEmptyAsZero() ZeroOption {
+	return func(o *zeroOptions) {
+		o.emptyAsZero = true
+	}
+}
+
+// RecurseIntoStructs treats a struct as zero when each of its fields is zero under the
+// same options, rather than comparing the whole struct with its zero value.
+// Structs with an IsZero() bool method, such as time.Time, still use that method.
+func RecurseIntoStructs() ZeroOption {
+	return func(o *zeroOptions) {
+		o.recurseStructs = true
+	}
+}
+
+// TreatPointerToZeroAsZero treats a non-nil pointer as zero when the value it points
+// to is zero under the same options.
+func TreatPointerToZeroAsZero() ZeroOption {
+	return func(o *zeroOptions) {
+		o.derefPointers = true
+	}
+}
+
+// IgnoreUnexportedFields skips unexported struct fields when recursing into structs,
+// so only exported fields decide whether a struct is zero.
+func IgnoreUnexportedFields() ZeroOption {
+	return func(o *zeroOptions) {
+		o.ignoreUnexported = true
+	}
+}
+
+func newZeroOptions(opts []ZeroOption) *zeroOptions {
+	o := &zeroOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// IsZeroValueWith returns true if the value v is zero under the given options.
+// Without options it behaves exactly like IsZeroValue.
+func IsZeroValueWith[T any](v T, opts ...ZeroOption) bool {
+	if len(opts) == 0 {
+		return IsZeroValue(v)
+	}
+
+	return isZeroWith(reflect.ValueOf(&v).Elem(), newZeroOptions(opts), make(visitSet))
+}
+
+var zeroerType = reflect.TypeFor[zeroer]()
+
+// visitKey identifies the target of a pointer. The type is included because a struct
+// and its first field share an address.
+type visitKey struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+// visitSet holds the pointers being followed by a recursive walk, so that cycles
+// can be detected.
+type visitSet map[visitKey]struct{}
+
+// enter adds the non-nil pointer p to the set. It returns false if p is already
+// being followed, meaning the walk has found a cycle.
+func (s visitSet) enter(p reflect.Value) bool {
+	k := visitKey{typ: p.Type(), ptr: p.Pointer()}
+	if _, ok := s[k]; ok {
+		return false
+	}
+	s[k] = struct{}{}
+
+	return true
+}
+
+// leave removes the pointer p from the set once the walk has finished with it.
+func (s visitSet) leave(p reflect.Value) {
+	delete(s, visitKey{typ: p.Type(), ptr: p.Pointer()})
+}
+
+// isZeroWith reports whether rv is zero under the options o. A pointer that is already
+// being followed is part of a cycle, and is not zero.
+func isZeroWith(rv reflect.Value, o *zeroOptions, visiting visitSet) bool {
+	if !rv.IsValid() {
 		return true
 	}
-	return reflect.DeepEqual(v, reflect.Zero(t).Interface())
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		return rv.IsNil() || isZeroWith(rv.Elem(), o, visiting)
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return true
+		}
+		if !o.derefPointers || !visiting.enter(rv) {
+			return false
+		}
+		defer visiting.leave(rv)
+
+		return isZeroWith(rv.Elem(), o, visiting)
+	case reflect.Slice, reflect.Map:
+		return rv.IsNil() || (o.emptyAsZero && rv.Len() == 0)
+	case reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if !isZeroWith(rv.Index(i), o, visiting) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		if o.recurseStructs && !rv.Type().Implements(zeroerType) {
+			return isStructZeroWith(rv, o, visiting)
+		}
+	}
+
+	if rv.CanInterface() {
+		return IsZeroValue(rv.Interface())
+	}
+
+	return rv.IsZero()
+}
+
+// isStructZeroWith reports whether every considered field of the struct rv is zero.
+func isStructZeroWith(rv reflect.Value, o *zeroOptions, visiting visitSet) bool {
+	t := rv.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		if o.ignoreUnexported && !t.Field(i).IsExported() {
+			continue
+		}
+
+		if !isZeroWith(rv.Field(i), o, visiting) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ZeroFields returns the dotted paths of the zero-valued fields of a struct, or of the
+// struct a pointer points to, in field order. Zero is decided as by IsZeroValueWith
+// with the given options.
+//
+// ZeroFields always recurses into nested structs and non-nil pointers to structs.
+// A nested struct whose fields are all zero is reported by its own path rather than
+// by the paths of its fields. Embedded structs are named by their type name.
+// Pointers that lead back to a struct already being reported form a cycle and are
+// not followed again.
+// If v is not a struct or a non-nil pointer to one, ZeroFields returns nil.
+func ZeroFields(v any, opts ...ZeroOption) []string {
+	o := newZeroOptions(append([]ZeroOption{RecurseIntoStructs()}, opts...))
+	visiting := make(visitSet)
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() && visiting.enter(rv) {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return appendZeroFields(nil, rv, "", o, visiting)
+}
+
+func appendZeroFields(paths []string, rv reflect.Value, prefix string, o *zeroOptions, visiting visitSet) []string {
+	t := rv.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if o.ignoreUnexported && !field.IsExported() {
+			continue
+		}
+
+		path := prefix + field.Name
+		fv := rv.Field(i)
+
+		if isZeroWith(fv, o, visiting) {
+			paths = append(paths, path)
+			continue
+		}
+
+		paths = appendNestedZeroFields(paths, fv, path+".", o, visiting)
+	}
+
+	return paths
+}
+
+// appendNestedZeroFields recurses into fv if it is a struct or a non-nil pointer to
+// one, skipping pointers that are already being followed.
+func appendNestedZeroFields(paths []string, fv reflect.Value, prefix string, o *zeroOptions, visiting visitSet) []string {
+	switch {
+	case fv.Kind() == reflect.Pointer && !fv.IsNil():
+		if !visiting.enter(fv) {
+			return paths
+		}
+		defer visiting.leave(fv)
+
+		return appendNestedZeroFields(paths, fv.Elem(), prefix, o, visiting)
+	case fv.Kind() == reflect.Struct && !fv.Type().Implements(zeroerType):
+		return appendZeroFields(paths, fv, prefix, o, visiting)
+	}
+
+	return paths
 }
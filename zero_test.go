@@ -2,7 +2,10 @@ package generics
 
 import (
 	"fmt"
+	"math"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestIsZeroValue(t *testing.T) {
@@ -134,6 +137,82 @@ func TestIsZeroValueComplex(t *testing.T) {
 	})
 }
 
+func TestIsZeroValueFastPath(t *testing.T) {
+	type withInterface struct {
+		V any
+	}
+
+	type withSlice struct {
+		A int
+		S []int
+	}
+
+	type duration time.Duration
+
+	zeroTime := time.Time{}
+	zeroTimeInLocation := time.Time{}.In(time.FixedZone("X", 3600))
+
+	tests := []struct {
+		name     string
+		isZero   bool
+		expected bool
+	}{
+		{name: "float negative zero", isZero: IsZeroValue(math.Copysign(0, -1)), expected: true},
+		{name: "float NaN", isZero: IsZeroValue(math.NaN()), expected: false},
+		{name: "complex", isZero: IsZeroValue(complex(0, 1)), expected: false},
+		{name: "named integer", isZero: IsZeroValue(duration(0)), expected: true},
+		{name: "named integer non-zero", isZero: IsZeroValue(duration(1)), expected: false},
+		{name: "array", isZero: IsZeroValue([3]int{}), expected: true},
+		{name: "array non-zero", isZero: IsZeroValue([3]int{0, 1, 0}), expected: false},
+		{name: "struct with nil interface", isZero: IsZeroValue(withInterface{}), expected: true},
+		{name: "struct with uncomparable interface value", isZero: IsZeroValue(withInterface{V: []int{1}}), expected: false},
+		{name: "struct with nil slice", isZero: IsZeroValue(withSlice{}), expected: true},
+		{name: "struct with empty slice", isZero: IsZeroValue(withSlice{S: []int{}}), expected: false},
+		{name: "empty slice", isZero: IsZeroValue([]int{}), expected: false},
+		{name: "empty map", isZero: IsZeroValue(map[string]int{}), expected: false},
+		{name: "nil func", isZero: IsZeroValue[func()](nil), expected: true},
+		{name: "func", isZero: IsZeroValue(func() {}), expected: false},
+		{name: "nil channel", isZero: IsZeroValue[chan int](nil), expected: true},
+		{name: "nil error", isZero: IsZeroValue[error](nil), expected: true},
+		{name: "error", isZero: IsZeroValue(ErrNotFound), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.isZero != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.isZero)
+			}
+		})
+	}
+
+	t.Run("IsZero method", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			isZero   bool
+			expected bool
+		}{
+			{name: "zero time", isZero: IsZeroValue(zeroTime), expected: true},
+			{name: "zero time in another location", isZero: IsZeroValue(zeroTimeInLocation), expected: true},
+			{name: "non-zero time", isZero: IsZeroValue(time.Unix(1, 0)), expected: false},
+			{name: "nil time pointer", isZero: IsZeroValue[*time.Time](nil), expected: true},
+			{name: "pointer to zero time", isZero: IsZeroValue(&zeroTime), expected: false},
+			{name: "None", isZero: IsZeroValue(None[int]()), expected: true},
+			{name: "Some zero", isZero: IsZeroValue(Some(0)), expected: false},
+			{name: "zero reflect.Value", isZero: IsZeroValue(reflect.Value{}), expected: true},
+			{name: "non-zero reflect.Value", isZero: IsZeroValue(reflect.ValueOf(1)), expected: false},
+			{name: "zero reflect.Value in a slice", isZero: len(CompactAny([]reflect.Value{{}, reflect.ValueOf(1)})) == 1, expected: true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if tt.isZero != tt.expected {
+					t.Errorf("Expected %v, got %v", tt.expected, tt.isZero)
+				}
+			})
+		}
+	})
+}
+
 func ExampleIsZeroValue_slice() {
 	var a []int
 	fmt.Println(IsZeroValue(a))
@@ -155,3 +234,271 @@ func ExampleIsZeroValue_map() {
 	// true
 	// false
 }
+
+type zeroTestInner struct {
+	Host string
+	Port int
+}
+
+type zeroTestConfig struct {
+	Name     string
+	Tags     []string
+	Labels   map[string]string
+	Inner    zeroTestInner
+	Ptr      *zeroTestInner
+	Count    *int
+	Created  time.Time
+	internal string
+}
+
+func TestIsZeroValueWith(t *testing.T) {
+	zero := 0
+	one := 1
+
+	tests := []struct {
+		name     string
+		isZero   bool
+		expected bool
+	}{
+		{name: "no options", isZero: IsZeroValueWith([]int{}), expected: false},
+		{name: "empty slice", isZero: IsZeroValueWith([]int{}, TreatEmptyAsZero()), expected: true},
+		{name: "empty map", isZero: IsZeroValueWith(map[string]int{}, TreatEmptyAsZero()), expected: true},
+		{name: "non-empty slice", isZero: IsZeroValueWith([]int{1}, TreatEmptyAsZero()), expected: false},
+		{name: "pointer to zero", isZero: IsZeroValueWith(&zero), expected: false},
+		{name: "pointer to zero deref", isZero: IsZeroValueWith(&zero, TreatPointerToZeroAsZero()), expected: true},
+		{name: "pointer to non-zero deref", isZero: IsZeroValueWith(&one, TreatPointerToZeroAsZero()), expected: false},
+		{name: "nil pointer deref", isZero: IsZeroValueWith((*int)(nil), TreatPointerToZeroAsZero()), expected: true},
+		{
+			name:     "struct with empty slice",
+			isZero:   IsZeroValueWith(zeroTestConfig{Tags: []string{}}, TreatEmptyAsZero()),
+			expected: false,
+		},
+		{
+			name:     "struct with empty slice recursed",
+			isZero:   IsZeroValueWith(zeroTestConfig{Tags: []string{}}, TreatEmptyAsZero(), RecurseIntoStructs()),
+			expected: true,
+		},
+		{
+			name:     "struct with pointer to zero recursed",
+			isZero:   IsZeroValueWith(zeroTestConfig{Count: &zero}, RecurseIntoStructs(), TreatPointerToZeroAsZero()),
+			expected: true,
+		},
+		{
+			name:     "struct with unexported field",
+			isZero:   IsZeroValueWith(zeroTestConfig{internal: "x"}, RecurseIntoStructs()),
+			expected: false,
+		},
+		{
+			name:     "struct ignoring unexported field",
+			isZero:   IsZeroValueWith(zeroTestConfig{internal: "x"}, RecurseIntoStructs(), IgnoreUnexportedFields()),
+			expected: true,
+		},
+		{
+			name:     "time uses IsZero",
+			isZero:   IsZeroValueWith(time.Time{}.In(time.UTC), RecurseIntoStructs()),
+			expected: true,
+		},
+		{name: "nil interface", isZero: IsZeroValueWith[any](nil, TreatEmptyAsZero()), expected: true},
+		{name: "interface holding empty slice", isZero: IsZeroValueWith[any]([]int{}, TreatEmptyAsZero()), expected: true},
+		{name: "array of empty slices", isZero: IsZeroValueWith([2][]int{{}, {}}, TreatEmptyAsZero()), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.isZero != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.isZero)
+			}
+		})
+	}
+}
+
+func TestZeroFields(t *testing.T) {
+	zero := 0
+
+	tests := []struct {
+		name     string
+		value    any
+		opts     []ZeroOption
+		expected []string
+	}{
+		{
+			name:     "zero struct",
+			value:    zeroTestConfig{},
+			expected: []string{"Name", "Tags", "Labels", "Inner", "Ptr", "Count", "Created", "internal"},
+		},
+		{
+			name: "partially set",
+			value: zeroTestConfig{
+				Name:     "svc",
+				Tags:     []string{},
+				Inner:    zeroTestInner{Host: "localhost"},
+				Ptr:      &zeroTestInner{Port: 8080},
+				Count:    &zero,
+				Created:  time.Now(),
+				internal: "x",
+			},
+			expected: []string{"Labels", "Inner.Port", "Ptr.Host"},
+		},
+		{
+			name:     "pointer to struct",
+			value:    &zeroTestConfig{Name: "svc", Inner: zeroTestInner{Host: "h", Port: 1}, Created: time.Now()},
+			expected: []string{"Tags", "Labels", "Ptr", "Count", "internal"},
+		},
+		{
+			name: "with options",
+			value: zeroTestConfig{
+				Tags:     []string{},
+				Labels:   map[string]string{"a": "b"},
+				Ptr:      &zeroTestInner{},
+				Count:    &zero,
+				internal: "x",
+			},
+			opts:     []ZeroOption{TreatEmptyAsZero(), TreatPointerToZeroAsZero(), IgnoreUnexportedFields()},
+			expected: []string{"Name", "Tags", "Inner", "Ptr", "Count", "Created"},
+		},
+		{name: "not a struct", value: 42, expected: nil},
+		{name: "nil pointer", value: (*zeroTestConfig)(nil), expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ZeroFields(tt.value, tt.opts...)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+type zeroTestNode struct {
+	Name string
+	Next *zeroTestNode
+}
+
+func TestZeroValueCycles(t *testing.T) {
+	self := &zeroTestNode{}
+	self.Next = self
+
+	a := &zeroTestNode{}
+	b := &zeroTestNode{Next: a}
+	a.Next = b
+
+	t.Run("IsZeroValueWith", func(t *testing.T) {
+		opts := []ZeroOption{RecurseIntoStructs(), TreatPointerToZeroAsZero()}
+
+		if IsZeroValueWith(*self, opts...) {
+			t.Errorf("Expected a self-referencing value not to be zero")
+		}
+
+		if IsZeroValueWith(a, opts...) {
+			t.Errorf("Expected a cycle of two values not to be zero")
+		}
+
+		shared := &zeroTestNode{}
+		if !IsZeroValueWith([2]*zeroTestNode{shared, shared}, opts...) {
+			t.Errorf("Expected a pointer visited twice without a cycle to be zero")
+		}
+	})
+
+	t.Run("ZeroFields", func(t *testing.T) {
+		if got := ZeroFields(self); !reflect.DeepEqual(got, []string{"Name"}) {
+			t.Errorf("Expected [Name], got %v", got)
+		}
+
+		if got := ZeroFields(a); !reflect.DeepEqual(got, []string{"Name", "Next.Name"}) {
+			t.Errorf("Expected [Name Next.Name], got %v", got)
+		}
+
+		if got := ZeroFields(*self); !reflect.DeepEqual(got, []string{"Name", "Next.Name"}) {
+			t.Errorf("Expected [Name Next.Name], got %v", got)
+		}
+	})
+}
+
+func ExampleZeroFields() {
+	type Database struct {
+		Host string
+		Port int
+	}
+
+	type Config struct {
+		Name     string
+		Tags     []string
+		Database Database
+	}
+
+	cfg := Config{
+		Name:     "api",
+		Tags:     []string{},
+		Database: Database{Host: "localhost"},
+	}
+
+	fmt.Println(ZeroFields(cfg))
+	fmt.Println(ZeroFields(cfg, TreatEmptyAsZero()))
+	// Output:
+	// [Database.Port]
+	// [Tags Database.Port]
+}
+
+// reflectIsZeroValue is the reflection-only implementation IsZeroValue replaced,
+// kept as a baseline for the benchmarks.
+func reflectIsZeroValue[T any](v T) bool {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return true
+	}
+	return reflect.DeepEqual(v, reflect.Zero(t).Interface())
+}
+
+var benchmarkIsZeroSink bool
+
+func benchmarkIsZeroValue[T any](b *testing.B, values []T) {
+	b.Run("IsZeroValue", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchmarkIsZeroSink = IsZeroValue(values[i%len(values)])
+		}
+	})
+
+	b.Run("reflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchmarkIsZeroSink = reflectIsZeroValue(values[i%len(values)])
+		}
+	})
+}
+
+func BenchmarkIsZeroValueInt(b *testing.B) {
+	benchmarkIsZeroValue(b, []int{0, 1})
+}
+
+func BenchmarkIsZeroValueString(b *testing.B) {
+	benchmarkIsZeroValue(b, []string{"", "a"})
+}
+
+func BenchmarkIsZeroValueStruct(b *testing.B) {
+	type testStruct struct {
+		A int
+		B string
+		C float64
+	}
+
+	benchmarkIsZeroValue(b, []testStruct{{}, {A: 1, B: "a", C: 1.5}})
+}
+
+func BenchmarkIsZeroValuePointer(b *testing.B) {
+	v := 1
+	benchmarkIsZeroValue(b, []*int{nil, &v})
+}
+
+func BenchmarkIsZeroValueSlice(b *testing.B) {
+	benchmarkIsZeroValue(b, [][]int{nil, {1, 2, 3}})
+}
+
+func BenchmarkIsZeroValueMap(b *testing.B) {
+	benchmarkIsZeroValue(b, []map[string]int{nil, {"a": 1}})
+}
+
+func BenchmarkIsZeroValueTime(b *testing.B) {
+	benchmarkIsZeroValue(b, []time.Time{{}, time.Unix(1, 0)})
+}